@@ -21,6 +21,7 @@ import (
 )
 
 var nodeFeatureAPI bool
+var deviceInventory bool
 
 func main() {
 	var configFile string
@@ -37,7 +38,7 @@ func main() {
 		&cli.StringFlag{
 			Name:    "mig-strategy",
 			Value:   spec.MigStrategyNone,
-			Usage:   "the desired strategy for exposing MIG devices on GPUs that support it:\n\t\t[none | single | mixed]",
+			Usage:   "the desired strategy for exposing MIG devices on GPUs that support it:\n\t\t[none | single | mixed | auto]",
 			EnvVars: []string{"GFD_MIG_STRATEGY", "MIG_STRATEGY"},
 		},
 		&cli.BoolFlag{
@@ -89,6 +90,25 @@ func main() {
 			Usage:       "Use NFD NodeFeature API to publish labels",
 			EnvVars:     []string{"GFD_USE_NODE_FEATURE_API"},
 		},
+		&cli.BoolFlag{
+			Name:        "device-inventory",
+			Value:       false,
+			Destination: &deviceInventory,
+			Usage:       "Publish the UUIDs, serial numbers, board part numbers and PCI bus IDs of the devices as a JSON annotation",
+			EnvVars:     []string{"GFD_DEVICE_INVENTORY"},
+		},
+		&cli.BoolFlag{
+			Name:    "keep-full-gpu-labels",
+			Value:   false,
+			Usage:   "Keep the labels for GPUs with MIG disabled if the mig-strategy=single configuration is invalid",
+			EnvVars: []string{"GFD_KEEP_FULL_GPU_LABELS"},
+		},
+		&cli.StringFlag{
+			Name:    "host-root",
+			Value:   "/",
+			Usage:   "the path at which the host filesystem is available; sysfs and procfs files (including the machine-type-file) are read below this path",
+			EnvVars: []string{"GFD_HOST_ROOT"},
+		},
 	}
 
 	if err := c.Run(os.Args); err != nil {
@@ -98,6 +118,12 @@ func main() {
 }
 
 func validateFlags(config *spec.Config) error {
+	if err := lm.ValidateArchitectureTable(config.ArchitectureTable); err != nil {
+		return fmt.Errorf("invalid architecture table: %v", err)
+	}
+	if err := lm.ValidateDriverBranchTable(config.DriverBranchTable); err != nil {
+		return fmt.Errorf("invalid driver branch table: %v", err)
+	}
 	return nil
 }
 
@@ -139,7 +165,7 @@ func start(c *cli.Context, flags []cli.Flag) error {
 		klog.Infof("\nRunning with config:\n%v", string(configJSON))
 
 		manager := resource.NewManager(config)
-		vgpul := vgpu.NewVGPULib(vgpu.NewNvidiaPCILib())
+		vgpul := vgpu.NewVGPULib(vgpu.NewNvidiaPCILib(*config.Flags.GFD.HostRoot))
 
 		klog.Info("Start running")
 		restart, err := run(manager, vgpul, config, sigs)
@@ -164,8 +190,14 @@ func run(manager resource.Manager, vgpu vgpu.Interface, config *spec.Config, sig
 	}()
 
 	timestampLabeler := lm.NewTimestampLabeler(config)
+	nodeEvents := lm.NewNodeEventRecorder()
+
+	var inventoryPublisher *lm.InventoryPublisher
+	if deviceInventory {
+		inventoryPublisher = lm.NewInventoryPublisher(nodeFeatureAPI)
+	}
 rerun:
-	loopLabelers, err := lm.NewLabelers(manager, vgpu, config)
+	loopLabelers, err := lm.NewLabelers(manager, vgpu, config, inventoryPublisher, nodeEvents)
 	if err != nil {
 		return false, err
 	}
@@ -190,6 +222,14 @@ rerun:
 		return false, err
 	}
 
+	if inventoryPublisher != nil {
+		klog.Info("Publishing device inventory")
+		err = inventoryPublisher.Publish()
+		if err != nil {
+			klog.Warningf("Error publishing device inventory: %v", err)
+		}
+	}
+
 	if *config.Flags.GFD.Oneshot {
 		return false, nil
 	}
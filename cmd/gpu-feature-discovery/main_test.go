@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
 	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
 	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
 )
 
 const (
@@ -379,6 +380,57 @@ func TestFailOnNVMLInitError(t *testing.T) {
 	}
 }
 
+func TestLoadConfigHostRoot(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("version: v1\nflags:\n  gfd:\n    hostRoot: /host\n"), 0644))
+
+	testCases := []struct {
+		description      string
+		args             []string
+		expectedHostRoot string
+	}{
+		{
+			description:      "default",
+			expectedHostRoot: "/",
+		},
+		{
+			description:      "flag",
+			args:             []string{"--host-root", "/flag"},
+			expectedHostRoot: "/flag",
+		},
+		{
+			description:      "config file",
+			args:             []string{"--config-file", configFile},
+			expectedHostRoot: "/host",
+		},
+		{
+			description:      "flag overrides config file",
+			args:             []string{"--config-file", configFile, "--host-root", "/flag"},
+			expectedHostRoot: "/flag",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var config *spec.Config
+			app := cli.NewApp()
+			app.Flags = []cli.Flag{
+				&cli.StringFlag{Name: "config-file"},
+				&cli.BoolFlag{Name: "fail-on-init-error", Value: true},
+				&cli.StringFlag{Name: "host-root", Value: "/"},
+			}
+			app.Action = func(c *cli.Context) error {
+				var err error
+				config, err = loadConfig(c, app.Flags)
+				return err
+			}
+
+			require.NoError(t, app.Run(append([]string{"gfd"}, tc.args...)))
+			require.Equal(t, tc.expectedHostRoot, *config.Flags.GFD.HostRoot)
+		})
+	}
+}
+
 func buildLabelMapFromOutput(output []byte) (map[string]string, error) {
 	labels := make(map[string]string)
 
@@ -0,0 +1,87 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package confcompute provides bindings for the NVML confidential computing API.
+// These functions are not yet available in the go-nvml bindings. NVML is
+// expected to have been initialized (e.g. through go-nvml) before they are called.
+package confcompute
+
+import (
+	"github.com/NVIDIA/gpu-feature-discovery/internal/nvmlsym"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Confidential computing system states as defined in nvml.h
+const (
+	CC_SYSTEM_ENVIRONMENT_UNAVAILABLE = 0
+	CC_SYSTEM_ENVIRONMENT_SIM         = 1
+	CC_SYSTEM_ENVIRONMENT_PROD        = 2
+
+	CC_SYSTEM_FEATURE_DISABLED = 0
+	CC_SYSTEM_FEATURE_ENABLED  = 1
+
+	CC_SYSTEM_DEVTOOLS_MODE_OFF = 0
+	CC_SYSTEM_DEVTOOLS_MODE_ON  = 1
+)
+
+// SystemState holds the confidential computing state of the system.
+type SystemState struct {
+	Environment  uint32
+	CCFeature    uint32
+	DevToolsMode uint32
+}
+
+// MemSizeInfo holds the protected and unprotected memory sizes of a device in KiB.
+type MemSizeInfo struct {
+	ProtectedMemSizeKib   uint64
+	UnprotectedMemSizeKib uint64
+}
+
+// SystemGetConfComputeState returns the confidential computing state of the system.
+func SystemGetConfComputeState() (SystemState, nvml.Return) {
+	var state SystemState
+	if !nvmlsym.Has("nvmlSystemGetConfComputeState") {
+		return state, nvml.ERROR_FUNCTION_NOT_FOUND
+	}
+	r := nvmlSystemGetConfComputeState(&state)
+
+	return state, r
+}
+
+// SystemGetConfComputeGpusReadyState returns whether the GPUs are ready to accept work in confidential computing mode.
+func SystemGetConfComputeGpusReadyState() (bool, nvml.Return) {
+	if !nvmlsym.Has("nvmlSystemGetConfComputeGpusReadyState") {
+		return false, nvml.ERROR_FUNCTION_NOT_FOUND
+	}
+
+	var isAcceptingWork uint32
+	r := nvmlSystemGetConfComputeGpusReadyState(&isAcceptingWork)
+
+	return isAcceptingWork != 0, r
+}
+
+// DeviceGetConfComputeMemSizeInfo returns the protected and unprotected memory sizes of the device.
+// Devices that do not support confidential computing return ERROR_NOT_SUPPORTED.
+func DeviceGetConfComputeMemSizeInfo(device nvml.Device) (MemSizeInfo, nvml.Return) {
+	var info MemSizeInfo
+	if !nvmlsym.Has("nvmlDeviceGetConfComputeMemSizeInfo") {
+		return info, nvml.ERROR_FUNCTION_NOT_FOUND
+	}
+	r := nvmlDeviceGetConfComputeMemSizeInfo(device, &info)
+
+	return info, r
+}
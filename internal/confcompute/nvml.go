@@ -0,0 +1,81 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package confcompute
+
+import (
+	"unsafe"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+/*
+#cgo LDFLAGS: -Wl,--unresolved-symbols=ignore-in-object-files
+
+typedef int nvmlReturn_t;
+
+typedef struct nvmlConfComputeSystemState_st {
+    unsigned int environment;
+    unsigned int ccFeature;
+    unsigned int devToolsMode;
+} nvmlConfComputeSystemState_t;
+
+typedef struct nvmlConfComputeMemSizeInfo_st {
+    unsigned long long protectedMemSizeKib;
+    unsigned long long unprotectedMemSizeKib;
+} nvmlConfComputeMemSizeInfo_t;
+
+typedef struct nvmlDevice_st* nvmlDevice_t;
+
+nvmlReturn_t nvmlSystemGetConfComputeState(nvmlConfComputeSystemState_t *state);
+nvmlReturn_t nvmlSystemGetConfComputeGpusReadyState(unsigned int *isAcceptingWork);
+nvmlReturn_t nvmlDeviceGetConfComputeMemSizeInfo(nvmlDevice_t device, nvmlConfComputeMemSizeInfo_t *memInfo);
+*/
+import "C"
+
+// nvmlSystemGetConfComputeState function as declared in nvml.h
+func nvmlSystemGetConfComputeState(state *SystemState) nvml.Return {
+	var cState C.nvmlConfComputeSystemState_t
+	_ret := C.nvmlSystemGetConfComputeState(&cState)
+
+	state.Environment = uint32(cState.environment)
+	state.CCFeature = uint32(cState.ccFeature)
+	state.DevToolsMode = uint32(cState.devToolsMode)
+
+	return nvml.Return(_ret)
+}
+
+// nvmlSystemGetConfComputeGpusReadyState function as declared in nvml.h
+func nvmlSystemGetConfComputeGpusReadyState(isAcceptingWork *uint32) nvml.Return {
+	var cIsAcceptingWork C.uint
+	_ret := C.nvmlSystemGetConfComputeGpusReadyState(&cIsAcceptingWork)
+
+	*isAcceptingWork = uint32(cIsAcceptingWork)
+
+	return nvml.Return(_ret)
+}
+
+// nvmlDeviceGetConfComputeMemSizeInfo function as declared in nvml.h
+func nvmlDeviceGetConfComputeMemSizeInfo(device nvml.Device, memInfo *MemSizeInfo) nvml.Return {
+	cDevice := *(*C.nvmlDevice_t)(unsafe.Pointer(&device))
+	var cMemInfo C.nvmlConfComputeMemSizeInfo_t
+	_ret := C.nvmlDeviceGetConfComputeMemSizeInfo(cDevice, &cMemInfo)
+
+	memInfo.ProtectedMemSizeKib = uint64(cMemInfo.protectedMemSizeKib)
+	memInfo.UnprotectedMemSizeKib = uint64(cMemInfo.unprotectedMemSizeKib)
+
+	return nvml.Return(_ret)
+}
@@ -87,8 +87,27 @@ const (
 type DeviceAttribute int32
 
 const (
+	CLOCK_RATE               DeviceAttribute = 13
+	MULTIPROCESSOR_COUNT     DeviceAttribute = 16
+	INTEGRATED               DeviceAttribute = 18
+	COMPUTE_MODE             DeviceAttribute = 20
+	CONCURRENT_KERNELS       DeviceAttribute = 31
+	MEMORY_CLOCK_RATE        DeviceAttribute = 36
+	GLOBAL_MEMORY_BUS_WIDTH  DeviceAttribute = 37
+	L2_CACHE_SIZE            DeviceAttribute = 38
+	UNIFIED_ADDRESSING       DeviceAttribute = 41
 	COMPUTE_CAPABILITY_MAJOR DeviceAttribute = 75
 	COMPUTE_CAPABILITY_MINOR DeviceAttribute = 76
+	MANAGED_MEMORY           DeviceAttribute = 83
+)
+
+// ComputeMode represents the CUcomputemode type
+type ComputeMode int32
+
+const (
+	COMPUTEMODE_DEFAULT           ComputeMode = 0
+	COMPUTEMODE_PROHIBITED        ComputeMode = 2
+	COMPUTEMODE_EXCLUSIVE_PROCESS ComputeMode = 3
 )
 
 // Device represents a CUDA device handle
@@ -0,0 +1,97 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+
+	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
+)
+
+// ArchitectureTableVersion is the version of the architecture table format understood by GFD.
+const ArchitectureTableVersion = "v1"
+
+const undefinedArchFamily = "undefined"
+
+// defaultArchitectureTable is the architecture table that ships with GFD.
+var defaultArchitectureTable = spec.ArchitectureTable{
+	Version: ArchitectureTableVersion,
+	Architectures: []spec.Architecture{
+		{Family: "tesla", Major: 1},
+		{Family: "fermi", Major: 2},
+		{Family: "kepler", Major: 3},
+		{Family: "maxwell", Major: 5},
+		{Family: "pascal", Major: 6},
+		{Family: "volta", Major: 7, MaxMinor: maxMinor(4)},
+		{Family: "turing", Major: 7, MinMinor: 5},
+		{Family: "ampere", Major: 8, MaxMinor: maxMinor(8)},
+		{Family: "ada", Major: 8, MinMinor: 9},
+		{Family: "hopper", Major: 9},
+		{Family: "blackwell", Major: 10},
+		{Family: "blackwell", Major: 11},
+		{Family: "blackwell", Major: 12},
+	},
+}
+
+// ValidateArchitectureTable checks that the architecture table from the config file has a supported
+// version and well-formed entries. A nil table is valid.
+func ValidateArchitectureTable(t *spec.ArchitectureTable) error {
+	if t == nil {
+		return nil
+	}
+	if t.Version != ArchitectureTableVersion {
+		return fmt.Errorf("unsupported version %q", t.Version)
+	}
+	for _, a := range t.Architectures {
+		if a.Family == "" {
+			return fmt.Errorf("missing family for compute capability major version %d", a.Major)
+		}
+		if a.MaxMinor != nil && *a.MaxMinor < a.MinMinor {
+			return fmt.Errorf("invalid minor version range [%d, %d] for %v", a.MinMinor, *a.MaxMinor, a.Family)
+		}
+	}
+	return nil
+}
+
+// architectureMatches checks whether the specified compute capability belongs to the architecture.
+func architectureMatches(a spec.Architecture, computeMajor, computeMinor int) bool {
+	if a.Major != computeMajor || computeMinor < a.MinMinor {
+		return false
+	}
+	return a.MaxMinor == nil || computeMinor <= *a.MaxMinor
+}
+
+// getArchFamily returns the architecture family for the specified compute capability.
+// The architecture table from the config file is checked before the default architecture table.
+func getArchFamily(config *spec.Config, computeMajor, computeMinor int) string {
+	var overrides []spec.Architecture
+	if config != nil && config.ArchitectureTable != nil {
+		overrides = config.ArchitectureTable.Architectures
+	}
+	for _, architectures := range [][]spec.Architecture{overrides, defaultArchitectureTable.Architectures} {
+		for _, a := range architectures {
+			if architectureMatches(a, computeMajor, computeMinor) {
+				return a.Family
+			}
+		}
+	}
+	return undefinedArchFamily
+}
+
+func maxMinor(minor int) *int {
+	return &minor
+}
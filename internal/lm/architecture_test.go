@@ -0,0 +1,163 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+	"testing"
+
+	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestGetArchFamily(t *testing.T) {
+	testCases := []struct {
+		major          int
+		minor          int
+		overrides      []spec.Architecture
+		expectedFamily string
+	}{
+		{major: 3, minor: 5, expectedFamily: "kepler"},
+		{major: 7, minor: 0, expectedFamily: "volta"},
+		{major: 7, minor: 2, expectedFamily: "volta"},
+		{major: 7, minor: 5, expectedFamily: "turing"},
+		{major: 8, minor: 0, expectedFamily: "ampere"},
+		{major: 8, minor: 6, expectedFamily: "ampere"},
+		{major: 8, minor: 7, expectedFamily: "ampere"},
+		{major: 8, minor: 9, expectedFamily: "ada"},
+		{major: 9, minor: 0, expectedFamily: "hopper"},
+		{major: 10, minor: 0, expectedFamily: "blackwell"},
+		{major: 12, minor: 0, expectedFamily: "blackwell"},
+		{major: 4, minor: 0, expectedFamily: "undefined"},
+		{major: 13, minor: 0, expectedFamily: "undefined"},
+		{
+			major:          13,
+			minor:          0,
+			overrides:      []spec.Architecture{{Family: "rubin", Major: 13}},
+			expectedFamily: "rubin",
+		},
+		{
+			major:          8,
+			minor:          9,
+			overrides:      []spec.Architecture{{Family: "lovelace", Major: 8, MinMinor: 9, MaxMinor: maxMinor(9)}},
+			expectedFamily: "lovelace",
+		},
+		{
+			major:          8,
+			minor:          6,
+			overrides:      []spec.Architecture{{Family: "lovelace", Major: 8, MinMinor: 9, MaxMinor: maxMinor(9)}},
+			expectedFamily: "ampere",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%d.%d is %v", tc.major, tc.minor, tc.expectedFamily), func(t *testing.T) {
+			config := &spec.Config{
+				ArchitectureTable: &spec.ArchitectureTable{Version: ArchitectureTableVersion, Architectures: tc.overrides},
+			}
+
+			require.Equal(t, tc.expectedFamily, getArchFamily(config, tc.major, tc.minor))
+		})
+	}
+}
+
+func TestValidateArchitectureTable(t *testing.T) {
+	testCases := []struct {
+		description   string
+		config        string
+		expectedTable *spec.ArchitectureTable
+		expectedError bool
+	}{
+		{
+			description: "config without architecture table",
+			config: `
+version: v1
+flags:
+  migStrategy: none
+`,
+		},
+		{
+			description: "config with architecture table",
+			config: `
+version: v1
+architectureTable:
+  version: v1
+  architectures:
+  - family: rubin
+    major: 13
+  - family: lovelace
+    major: 8
+    minMinor: 9
+    maxMinor: 9
+`,
+			expectedTable: &spec.ArchitectureTable{
+				Version: "v1",
+				Architectures: []spec.Architecture{
+					{Family: "rubin", Major: 13},
+					{Family: "lovelace", Major: 8, MinMinor: 9, MaxMinor: maxMinor(9)},
+				},
+			},
+		},
+		{
+			description: "unsupported version is an error",
+			config: `
+architectureTable:
+  version: v2
+`,
+			expectedError: true,
+		},
+		{
+			description: "missing family is an error",
+			config: `
+architectureTable:
+  version: v1
+  architectures:
+  - major: 13
+`,
+			expectedError: true,
+		},
+		{
+			description: "invalid minor version range is an error",
+			config: `
+architectureTable:
+  version: v1
+  architectures:
+  - family: rubin
+    major: 13
+    minMinor: 2
+    maxMinor: 1
+`,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var config spec.Config
+			require.NoError(t, yaml.Unmarshal([]byte(tc.config), &config))
+
+			err := ValidateArchitectureTable(config.ArchitectureTable)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.EqualValues(t, tc.expectedTable, config.ArchitectureTable)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+)
+
+// newComputeModeLabeler creates a labeler for the compute mode of the GPUs on the node.
+// If the GPUs on the node are configured with different compute modes the label is set to mixed.
+func newComputeModeLabeler(manager resource.Manager) (Labeler, error) {
+	devices, err := manager.GetDevices()
+	if err != nil {
+		return nil, err
+	}
+	if len(devices) == 0 {
+		// no devices, return empty labels
+		return empty{}, nil
+	}
+
+	computeMode, err := commonValue(devices, resource.Device.GetComputeMode)
+	if err != nil {
+		return nil, fmt.Errorf("error getting compute mode: %v", err)
+	}
+
+	labels := Labels{
+		"nvidia.com/gpu.compute-mode": computeMode,
+	}
+	return labels, nil
+}
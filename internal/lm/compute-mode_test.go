@@ -0,0 +1,81 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeModeLabeler(t *testing.T) {
+	testCases := []struct {
+		description    string
+		devices        []resource.Device
+		expectedLabels Labels
+	}{
+		{
+			description: "no devices returns empty labels",
+		},
+		{
+			description: "single device returns its compute mode",
+			devices: []resource.Device{
+				rt.NewDeviceMock(false).WithComputeMode(resource.ComputeModeExclusiveProcess),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.compute-mode": "exclusive-process",
+			},
+		},
+		{
+			description: "multiple devices with the same compute mode",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+				rt.NewFullGPU(),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.compute-mode": "default",
+			},
+		},
+		{
+			description: "multiple devices with different compute modes returns mixed",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+				rt.NewDeviceMock(false).WithComputeMode(resource.ComputeModeExclusiveProcess),
+				rt.NewFullGPU(),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.compute-mode": "mixed",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			manager := rt.NewManagerMockWithDevices(tc.devices...)
+
+			computeModeLabeler, err := newComputeModeLabeler(manager)
+			require.NoError(t, err)
+
+			labels, err := computeModeLabeler.Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+)
+
+// newConfComputeLabeler creates a labeler for the confidential computing (CC) mode of the node and
+// whether the GPUs are ready to accept work. The per-GPU CC capability and, with CC enabled, the smallest
+// protected memory size of the GPUs are also included. No labels are generated if CC is not supported.
+func newConfComputeLabeler(manager resource.Manager) (Labeler, error) {
+	state, err := manager.GetConfComputeState()
+	if errors.Is(err, resource.ErrNotSupported) {
+		return empty{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting confidential computing state: %v", err)
+	}
+
+	labels := Labels{
+		"nvidia.com/cc.mode":        state.Mode,
+		"nvidia.com/cc.ready.state": strconv.FormatBool(state.Ready),
+	}
+
+	devices, err := manager.GetDevices()
+	if err != nil {
+		return nil, fmt.Errorf("error getting devices: %v", err)
+	}
+	if len(devices) == 0 {
+		return labels, nil
+	}
+
+	var protectedMemory []uint64
+	capable, err := commonValue(devices, func(d resource.Device) (string, error) {
+		size, err := d.GetConfComputeProtectedMemoryMiB()
+		if errors.Is(err, resource.ErrNotSupported) {
+			return "false", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		protectedMemory = append(protectedMemory, size)
+		return "true", nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting GPU confidential computing state: %v", err)
+	}
+	labels["nvidia.com/cc.capable"] = capable
+
+	if capable != "true" || state.Mode == resource.ConfComputeModeOff {
+		return labels, nil
+	}
+	minProtectedMemory := protectedMemory[0]
+	for _, size := range protectedMemory[1:] {
+		if size < minProtectedMemory {
+			minProtectedMemory = size
+		}
+	}
+	labels["nvidia.com/cc.protected-memory"] = strconv.FormatUint(minProtectedMemory, 10)
+
+	return labels, nil
+}
@@ -0,0 +1,110 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfComputeLabeler(t *testing.T) {
+	newCCGPU := func(protectedMemoryMiB uint64) resource.Device {
+		return rt.NewFullGPU().(*rt.DeviceMock).WithConfComputeProtectedMemoryMiB(protectedMemoryMiB)
+	}
+
+	testCases := []struct {
+		description    string
+		manager        resource.Manager
+		expectedLabels Labels
+	}{
+		{
+			description: "confidential computing not supported returns empty labels",
+			manager:     rt.NewManagerMockWithDevices(rt.NewFullGPU()),
+		},
+		{
+			description: "confidential computing off",
+			manager: rt.NewManagerMockWithDevices(rt.NewFullGPU()).WithConfComputeState(
+				resource.ConfComputeState{Mode: resource.ConfComputeModeOff},
+			),
+			expectedLabels: Labels{
+				"nvidia.com/cc.mode":        "off",
+				"nvidia.com/cc.ready.state": "false",
+				"nvidia.com/cc.capable":     "false",
+			},
+		},
+		{
+			description: "confidential computing off on capable GPUs",
+			manager: rt.NewManagerMockWithDevices(newCCGPU(0)).WithConfComputeState(
+				resource.ConfComputeState{Mode: resource.ConfComputeModeOff},
+			),
+			expectedLabels: Labels{
+				"nvidia.com/cc.mode":        "off",
+				"nvidia.com/cc.ready.state": "false",
+				"nvidia.com/cc.capable":     "true",
+			},
+		},
+		{
+			description: "confidential computing on and ready",
+			manager: rt.NewManagerMockWithDevices(newCCGPU(75776), newCCGPU(74752)).WithConfComputeState(
+				resource.ConfComputeState{Mode: resource.ConfComputeModeOn, Ready: true},
+			),
+			expectedLabels: Labels{
+				"nvidia.com/cc.mode":             "on",
+				"nvidia.com/cc.ready.state":      "true",
+				"nvidia.com/cc.capable":          "true",
+				"nvidia.com/cc.protected-memory": "74752",
+			},
+		},
+		{
+			description: "confidential computing on with GPUs that are not capable",
+			manager: rt.NewManagerMockWithDevices(newCCGPU(75776), rt.NewFullGPU()).WithConfComputeState(
+				resource.ConfComputeState{Mode: resource.ConfComputeModeOn},
+			),
+			expectedLabels: Labels{
+				"nvidia.com/cc.mode":        "on",
+				"nvidia.com/cc.ready.state": "false",
+				"nvidia.com/cc.capable":     "mixed",
+			},
+		},
+		{
+			description: "confidential computing in devtools mode and not ready",
+			manager: rt.NewManagerMockWithDevices(rt.NewFullGPU()).WithConfComputeState(
+				resource.ConfComputeState{Mode: resource.ConfComputeModeDevTools},
+			),
+			expectedLabels: Labels{
+				"nvidia.com/cc.mode":        "devtools",
+				"nvidia.com/cc.ready.state": "false",
+				"nvidia.com/cc.capable":     "false",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			confComputeLabeler, err := newConfComputeLabeler(tc.manager)
+			require.NoError(t, err)
+
+			labels, err := confComputeLabeler.Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
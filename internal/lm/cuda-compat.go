@@ -0,0 +1,150 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	"k8s.io/klog/v2"
+)
+
+// cudaCompatGlob matches the driver libraries of the CUDA forward-compatibility packages
+// installed in the versioned CUDA toolkit directories.
+const cudaCompatGlob = "/usr/local/cuda-*/compat/libcuda.so.*"
+
+// cudaToolkitDirRegexp extracts the CUDA version from a versioned CUDA toolkit directory name.
+var cudaToolkitDirRegexp = regexp.MustCompile(`^cuda-(\d+)\.(\d+)$`)
+
+// cudaCompatLibraryRegexp extracts the driver version from the name of a forward-compatibility library,
+// e.g. libcuda.so.545.23.08.
+var cudaCompatLibraryRegexp = regexp.MustCompile(`^libcuda\.so\.(\d+(?:\.\d+)*)$`)
+
+// cudaVersion represents a CUDA major.minor version.
+type cudaVersion struct {
+	major uint
+	minor uint
+}
+
+func (v cudaVersion) greaterThan(o cudaVersion) bool {
+	if v.major != o.major {
+		return v.major > o.major
+	}
+	return v.minor > o.minor
+}
+
+// newCudaCompatLabeler creates a labeler for the CUDA forward-compatibility libraries.
+// Forward compatibility is available if the libraries for a newer CUDA version than the one supported
+// by the driver are installed on the host and were built for a newer driver than the one that is
+// loaded. The maximum usable CUDA version is the newer of the two. Whether the libraries are made
+// available to a container depends on the container image and runtime, so the labels only describe
+// what is installed on the host. If the driver version is not known, no forward compatibility is reported.
+func newCudaCompatLabeler(manager resource.Manager, compatGlob string) (Labeler, error) {
+	major, minor, err := manager.GetCudaDriverVersion()
+	if err != nil {
+		return nil, fmt.Errorf("error getting cuda driver version: %v", err)
+	}
+	driverCudaVersion := cudaVersion{major: *major, minor: *minor}
+
+	driverVersion, err := manager.GetDriverVersion()
+	if err != nil && !errors.Is(err, resource.ErrNotSupported) {
+		return nil, fmt.Errorf("error getting driver version: %v", err)
+	}
+
+	var compatVersions []cudaVersion
+	if err == nil {
+		compatVersions, err = getCudaCompatVersions(compatGlob, driverVersion)
+		if err != nil {
+			return nil, fmt.Errorf("error getting CUDA forward-compatibility versions: %v", err)
+		}
+	}
+
+	maxVersion := driverCudaVersion
+	for _, v := range compatVersions {
+		if v.greaterThan(maxVersion) {
+			maxVersion = v
+		}
+	}
+	forwardCompat := maxVersion.greaterThan(driverCudaVersion)
+	if forwardCompat {
+		klog.Infof("Detected CUDA forward compatibility up to %d.%d", maxVersion.major, maxVersion.minor)
+	}
+
+	labels := Labels{
+		"nvidia.com/cuda.forward-compat":    strconv.FormatBool(forwardCompat),
+		"nvidia.com/cuda.max-version.major": fmt.Sprintf("%d", maxVersion.major),
+		"nvidia.com/cuda.max-version.minor": fmt.Sprintf("%d", maxVersion.minor),
+	}
+	return labels, nil
+}
+
+// getCudaCompatVersions returns the CUDA versions of the installed forward-compatibility libraries
+// that were built for a newer driver than the specified one. Older libraries are not used by the driver.
+func getCudaCompatVersions(compatGlob string, driverVersion string) ([]cudaVersion, error) {
+	libraries, err := filepath.Glob(compatGlob)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []cudaVersion
+	for _, library := range libraries {
+		toolkitDir := filepath.Base(filepath.Dir(filepath.Dir(library)))
+		match := cudaToolkitDirRegexp.FindStringSubmatch(toolkitDir)
+		if match == nil {
+			klog.Warningf("Ignoring CUDA forward-compatibility library %v in unversioned directory", library)
+			continue
+		}
+		libraryMatch := cudaCompatLibraryRegexp.FindStringSubmatch(filepath.Base(library))
+		if libraryMatch == nil {
+			klog.Warningf("Ignoring CUDA forward-compatibility library %v without driver version", library)
+			continue
+		}
+		if !driverVersionGreaterThan(libraryMatch[1], driverVersion) {
+			klog.Infof("Ignoring CUDA forward-compatibility library %v not newer than driver %v", library, driverVersion)
+			continue
+		}
+		major, _ := strconv.ParseUint(match[1], 10, 32)
+		minor, _ := strconv.ParseUint(match[2], 10, 32)
+		versions = append(versions, cudaVersion{major: uint(major), minor: uint(minor)})
+	}
+	return versions, nil
+}
+
+// driverVersionGreaterThan compares two dotted driver versions (e.g. 545.23.08 and 535.104.05) numerically.
+// Missing components are treated as zero.
+func driverVersionGreaterThan(v string, o string) bool {
+	vParts := strings.Split(v, ".")
+	oParts := strings.Split(o, ".")
+	for i := 0; i < len(vParts) || i < len(oParts); i++ {
+		var a, b int
+		if i < len(vParts) {
+			a, _ = strconv.Atoi(vParts[i])
+		}
+		if i < len(oParts) {
+			b, _ = strconv.Atoi(oParts[i])
+		}
+		if a != b {
+			return a > b
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCudaCompatLabeler(t *testing.T) {
+	testCases := []struct {
+		description    string
+		libraries      []string
+		driverVersion  string
+		driverErr      error
+		expectedLabels Labels
+	}{
+		{
+			description: "no compat libraries",
+			expectedLabels: Labels{
+				"nvidia.com/cuda.forward-compat":    "false",
+				"nvidia.com/cuda.max-version.major": "8",
+				"nvidia.com/cuda.max-version.minor": "0",
+			},
+		},
+		{
+			description: "newer compat libraries",
+			libraries: []string{
+				"cuda-12.2/compat/libcuda.so.535.104.05",
+				"cuda-12.3/compat/libcuda.so.545.23.08",
+			},
+			expectedLabels: Labels{
+				"nvidia.com/cuda.forward-compat":    "true",
+				"nvidia.com/cuda.max-version.major": "12",
+				"nvidia.com/cuda.max-version.minor": "3",
+			},
+		},
+		{
+			description: "older compat libraries",
+			libraries: []string{
+				"cuda-7.5/compat/libcuda.so.352.39",
+			},
+			expectedLabels: Labels{
+				"nvidia.com/cuda.forward-compat":    "false",
+				"nvidia.com/cuda.max-version.major": "8",
+				"nvidia.com/cuda.max-version.minor": "0",
+			},
+		},
+		{
+			description: "compat libraries older than the driver are ignored",
+			libraries: []string{
+				"cuda-12.3/compat/libcuda.so.545.23.08",
+			},
+			driverVersion: "550.54.15",
+			expectedLabels: Labels{
+				"nvidia.com/cuda.forward-compat":    "false",
+				"nvidia.com/cuda.max-version.major": "8",
+				"nvidia.com/cuda.max-version.minor": "0",
+			},
+		},
+		{
+			description: "compat libraries are ignored if the driver version is unknown",
+			libraries: []string{
+				"cuda-12.3/compat/libcuda.so.545.23.08",
+			},
+			driverErr: resource.ErrNotSupported,
+			expectedLabels: Labels{
+				"nvidia.com/cuda.forward-compat":    "false",
+				"nvidia.com/cuda.max-version.major": "8",
+				"nvidia.com/cuda.max-version.minor": "0",
+			},
+		},
+		{
+			description: "unversioned directory is ignored",
+			libraries: []string{
+				"cuda-latest/compat/libcuda.so.545.23.08",
+			},
+			expectedLabels: Labels{
+				"nvidia.com/cuda.forward-compat":    "false",
+				"nvidia.com/cuda.max-version.major": "8",
+				"nvidia.com/cuda.max-version.minor": "0",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			root := t.TempDir()
+			for _, library := range tc.libraries {
+				path := filepath.Join(root, library)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, os.WriteFile(path, nil, 0644))
+			}
+
+			manager := rt.NewManagerMockWithDevices(rt.NewFullGPU())
+			if tc.driverVersion != "" || tc.driverErr != nil {
+				manager.GetDriverVersionFunc = func() (string, error) {
+					return tc.driverVersion, tc.driverErr
+				}
+			}
+
+			l, err := newCudaCompatLabeler(manager, filepath.Join(root, "cuda-*/compat/libcuda.so.*"))
+			require.NoError(t, err)
+
+			labels, err := l.Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+)
+
+const (
+	valueMixed    = "mixed"
+	valueEnabled  = "enabled"
+	valueDisabled = "disabled"
+)
+
+// newDeviceModeLabeler creates a labeler for the persistence mode and display mode of the GPUs on the node.
+// Labels for properties that are not supported on the node are omitted.
+func newDeviceModeLabeler(manager resource.Manager) (Labeler, error) {
+	devices, err := manager.GetDevices()
+	if err != nil {
+		return nil, err
+	}
+	if len(devices) == 0 {
+		// no devices, return empty labels
+		return empty{}, nil
+	}
+
+	properties := map[string]func(resource.Device) (string, error){
+		"nvidia.com/gpu.persistence-mode": func(d resource.Device) (string, error) {
+			return enabledState(d.IsPersistenceModeEnabled())
+		},
+		"nvidia.com/gpu.display-mode": func(d resource.Device) (string, error) {
+			return enabledState(d.IsDisplayModeEnabled())
+		},
+		"nvidia.com/gpu.display-active": func(d resource.Device) (string, error) {
+			return enabledState(d.IsDisplayActive())
+		},
+	}
+
+	labels := make(Labels)
+	for label, property := range properties {
+		value, err := commonValue(devices, property)
+		if errors.Is(err, resource.ErrNotSupported) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting value for %v: %v", label, err)
+		}
+		labels[label] = value
+	}
+
+	return labels, nil
+}
+
+// commonValue returns the value of the specified property if it is the same for all devices.
+// If the value differs between devices, mixed is returned.
+func commonValue(devices []resource.Device, property func(resource.Device) (string, error)) (string, error) {
+	var common string
+	for _, d := range devices {
+		value, err := property(d)
+		if err != nil {
+			return "", err
+		}
+		if common == "" {
+			common = value
+		}
+		if common != value {
+			return valueMixed, nil
+		}
+	}
+	return common, nil
+}
+
+// enabledState converts a boolean device property to an enabled / disabled value.
+func enabledState(enabled bool, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	if enabled {
+		return valueEnabled, nil
+	}
+	return valueDisabled, nil
+}
@@ -0,0 +1,109 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceModeLabeler(t *testing.T) {
+	unsupported := func() *rt.DeviceMock {
+		d := rt.NewDeviceMock(false)
+		d.IsPersistenceModeEnabledFunc = func() (bool, error) {
+			return false, fmt.Errorf("IsPersistenceModeEnabled is %w for CUDA devices", resource.ErrNotSupported)
+		}
+		d.IsDisplayModeEnabledFunc = func() (bool, error) { return false, resource.ErrNotSupported }
+		d.IsDisplayActiveFunc = func() (bool, error) { return false, resource.ErrNotSupported }
+		return d
+	}
+
+	testCases := []struct {
+		description    string
+		devices        []resource.Device
+		expectedError  bool
+		expectedLabels Labels
+	}{
+		{
+			description: "no devices returns empty labels",
+		},
+		{
+			description: "single device",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.persistence-mode": "enabled",
+				"nvidia.com/gpu.display-mode":     "disabled",
+				"nvidia.com/gpu.display-active":   "disabled",
+			},
+		},
+		{
+			description: "differing persistence mode returns mixed",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+				rt.NewDeviceMock(false).WithPersistenceMode(false),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.persistence-mode": "mixed",
+				"nvidia.com/gpu.display-mode":     "disabled",
+				"nvidia.com/gpu.display-active":   "disabled",
+			},
+		},
+		{
+			description: "unsupported properties are omitted",
+			devices: []resource.Device{
+				unsupported(),
+			},
+			expectedLabels: Labels{},
+		},
+		{
+			description: "other errors are returned",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+				&rt.DeviceMock{DeviceMock: resource.DeviceMock{
+					IsPersistenceModeEnabledFunc: func() (bool, error) { return false, fmt.Errorf("unknown error") },
+					IsDisplayModeEnabledFunc:     func() (bool, error) { return false, fmt.Errorf("unknown error") },
+					IsDisplayActiveFunc:          func() (bool, error) { return false, fmt.Errorf("unknown error") },
+				}},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			manager := rt.NewManagerMockWithDevices(tc.devices...)
+
+			deviceModeLabeler, err := newDeviceModeLabeler(manager)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			labels, err := deviceModeLabeler.Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+
+	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
+)
+
+// DriverBranchTableVersion is the version of the driver branch table format understood by GFD.
+const DriverBranchTableVersion = "v1"
+
+const (
+	driverBranchTypeProduction = "production"
+	driverBranchTypeNewFeature = "new-feature"
+	driverBranchTypeUnknown    = "unknown"
+)
+
+// defaultDriverBranchTable is the driver branch table that ships with GFD.
+// Branches released after this table was last updated are labeled as unknown unless they are
+// added to the driverBranchTable section of the config file.
+var defaultDriverBranchTable = spec.DriverBranchTable{
+	Version: DriverBranchTableVersion,
+	Branches: []spec.DriverBranch{
+		{Branch: 418, Type: driverBranchTypeProduction},
+		{Branch: 440, Type: driverBranchTypeProduction},
+		{Branch: 450, Type: driverBranchTypeProduction},
+		{Branch: 455, Type: driverBranchTypeNewFeature},
+		{Branch: 460, Type: driverBranchTypeProduction},
+		{Branch: 465, Type: driverBranchTypeNewFeature},
+		{Branch: 470, Type: driverBranchTypeProduction},
+		{Branch: 495, Type: driverBranchTypeNewFeature},
+		{Branch: 510, Type: driverBranchTypeProduction},
+		{Branch: 515, Type: driverBranchTypeProduction},
+		{Branch: 520, Type: driverBranchTypeNewFeature},
+		{Branch: 525, Type: driverBranchTypeProduction},
+		{Branch: 530, Type: driverBranchTypeNewFeature},
+		{Branch: 535, Type: driverBranchTypeProduction},
+		{Branch: 545, Type: driverBranchTypeNewFeature},
+		{Branch: 550, Type: driverBranchTypeProduction},
+		{Branch: 555, Type: driverBranchTypeNewFeature},
+		{Branch: 560, Type: driverBranchTypeNewFeature},
+		{Branch: 565, Type: driverBranchTypeNewFeature},
+		{Branch: 570, Type: driverBranchTypeProduction},
+	},
+}
+
+// ValidateDriverBranchTable checks that the driver branch table from the config file has a supported
+// version and well-formed entries. A nil table is valid.
+func ValidateDriverBranchTable(t *spec.DriverBranchTable) error {
+	if t == nil {
+		return nil
+	}
+	if t.Version != DriverBranchTableVersion {
+		return fmt.Errorf("unsupported version %q", t.Version)
+	}
+	for _, b := range t.Branches {
+		switch b.Type {
+		case driverBranchTypeProduction, driverBranchTypeNewFeature:
+		default:
+			return fmt.Errorf("invalid type %q for branch %d", b.Type, b.Branch)
+		}
+	}
+	return nil
+}
+
+// getDriverBranchType returns the type of the driver branch with the specified major version.
+// The driver branch table from the config file is checked before the default driver branch table.
+func getDriverBranchType(config *spec.Config, major int) string {
+	var overrides []spec.DriverBranch
+	if config != nil && config.DriverBranchTable != nil {
+		overrides = config.DriverBranchTable.Branches
+	}
+	for _, branches := range [][]spec.DriverBranch{overrides, defaultDriverBranchTable.Branches} {
+		for _, b := range branches {
+			if b.Branch == major {
+				return b.Type
+			}
+		}
+	}
+	return driverBranchTypeUnknown
+}
@@ -0,0 +1,131 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+	"testing"
+
+	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestGetDriverBranchType(t *testing.T) {
+	testCases := []struct {
+		major        int
+		overrides    []spec.DriverBranch
+		expectedType string
+	}{
+		{major: 535, expectedType: "production"},
+		{major: 545, expectedType: "new-feature"},
+		{major: 400, expectedType: "unknown"},
+		{major: 580, expectedType: "unknown"},
+		{
+			major:        580,
+			overrides:    []spec.DriverBranch{{Branch: 580, Type: "production"}},
+			expectedType: "production",
+		},
+		{
+			major:        550,
+			overrides:    []spec.DriverBranch{{Branch: 550, Type: "new-feature"}},
+			expectedType: "new-feature",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("r%d is %v", tc.major, tc.expectedType), func(t *testing.T) {
+			config := &spec.Config{
+				DriverBranchTable: &spec.DriverBranchTable{Version: DriverBranchTableVersion, Branches: tc.overrides},
+			}
+
+			require.Equal(t, tc.expectedType, getDriverBranchType(config, tc.major))
+		})
+	}
+}
+
+func TestValidateDriverBranchTable(t *testing.T) {
+	testCases := []struct {
+		description   string
+		config        string
+		expectedTable *spec.DriverBranchTable
+		expectedError bool
+	}{
+		{
+			description: "config without driver branch table",
+			config: `
+version: v1
+flags:
+  migStrategy: none
+`,
+		},
+		{
+			description: "config with driver branch table",
+			config: `
+version: v1
+driverBranchTable:
+  version: v1
+  branches:
+  - branch: 575
+    type: new-feature
+  - branch: 580
+    type: production
+`,
+			expectedTable: &spec.DriverBranchTable{
+				Version: "v1",
+				Branches: []spec.DriverBranch{
+					{Branch: 575, Type: "new-feature"},
+					{Branch: 580, Type: "production"},
+				},
+			},
+		},
+		{
+			description: "unsupported version is an error",
+			config: `
+driverBranchTable:
+  version: v2
+`,
+			expectedError: true,
+		},
+		{
+			description: "invalid type is an error",
+			config: `
+driverBranchTable:
+  version: v1
+  branches:
+  - branch: 580
+    type: lts
+`,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var config spec.Config
+			require.NoError(t, yaml.Unmarshal([]byte(tc.config), &config))
+
+			err := ValidateDriverBranchTable(config.DriverBranchTable)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.EqualValues(t, tc.expectedTable, config.DriverBranchTable)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	kernelModuleOpen        = "open"
+	kernelModuleProprietary = "proprietary"
+)
+
+// newDriverLabeler creates a labeler for the driver branch, the flavor of the loaded kernel module and
+// the use of the GSP firmware by the GPUs. Labels for properties that cannot be determined are omitted.
+func newDriverLabeler(manager resource.Manager, config *spec.Config, versionFile string) (Labeler, error) {
+	labels := make(Labels)
+
+	driverVersion, err := manager.GetDriverVersion()
+	if err != nil && !errors.Is(err, resource.ErrNotSupported) {
+		return nil, fmt.Errorf("error getting driver version: %v", err)
+	}
+	if major, err := strconv.Atoi(strings.SplitN(driverVersion, ".", 2)[0]); err == nil {
+		labels["nvidia.com/driver.branch"] = fmt.Sprintf("r%d", major)
+		labels["nvidia.com/driver.branch-type"] = getDriverBranchType(config, major)
+	}
+
+	kernelModule, err := getKernelModuleFlavor(versionFile)
+	if err != nil {
+		klog.Infof("Unable to determine kernel module flavor from %v: %v", versionFile, err)
+	} else {
+		labels["nvidia.com/driver.kernel-module"] = kernelModule
+	}
+
+	devices, err := manager.GetDevices()
+	if err != nil {
+		return nil, fmt.Errorf("error getting devices: %v", err)
+	}
+	if len(devices) == 0 {
+		return labels, nil
+	}
+
+	gspFirmware, err := commonValue(devices, func(d resource.Device) (string, error) {
+		return enabledState(d.IsGspFirmwareEnabled())
+	})
+	if err != nil && !errors.Is(err, resource.ErrNotSupported) {
+		return nil, fmt.Errorf("error getting GSP firmware mode: %v", err)
+	}
+	if err == nil {
+		labels["nvidia.com/gpu.gsp-firmware"] = gspFirmware
+	}
+
+	return labels, nil
+}
+
+// getKernelModuleFlavor determines whether the open or proprietary NVIDIA kernel module is loaded from
+// the NVRM line of the driver version file, e.g.
+//
+//	NVRM version: NVIDIA UNIX Open Kernel Module for x86_64  535.104.05  Release Build  ...
+func getKernelModuleFlavor(versionFile string) (string, error) {
+	f, err := os.Open(versionFile)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "NVRM version:") {
+			continue
+		}
+		if strings.Contains(line, "Open Kernel Module") {
+			return kernelModuleOpen, nil
+		}
+		return kernelModuleProprietary, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no NVRM version found")
+}
@@ -0,0 +1,102 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriverLabeler(t *testing.T) {
+	testCases := []struct {
+		description    string
+		driverVersion  string
+		versionFile    string
+		devices        []resource.Device
+		expectedLabels Labels
+	}{
+		{
+			description:   "production branch with open kernel module",
+			driverVersion: "535.104.05",
+			versionFile:   "NVRM version: NVIDIA UNIX Open Kernel Module for x86_64  535.104.05  Release Build  (dvs-builder@U16-I3-B03-4-3)  Sat Aug 19 01:13:15 UTC 2023\n",
+			devices: []resource.Device{
+				rt.NewDeviceMock(false).WithGspFirmware(true),
+				rt.NewDeviceMock(false).WithGspFirmware(true),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/driver.branch":        "r535",
+				"nvidia.com/driver.branch-type":   "production",
+				"nvidia.com/driver.kernel-module": "open",
+				"nvidia.com/gpu.gsp-firmware":     "enabled",
+			},
+		},
+		{
+			description:   "new feature branch with proprietary kernel module",
+			driverVersion: "545.23.08",
+			versionFile:   "NVRM version: NVIDIA UNIX x86_64 Kernel Module  545.23.08  Mon Nov  6 23:49:37 UTC 2023\nGCC version:  gcc version 11.4.0\n",
+			devices: []resource.Device{
+				rt.NewDeviceMock(false).WithGspFirmware(true),
+				rt.NewDeviceMock(false).WithGspFirmware(false),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/driver.branch":        "r545",
+				"nvidia.com/driver.branch-type":   "new-feature",
+				"nvidia.com/driver.kernel-module": "proprietary",
+				"nvidia.com/gpu.gsp-firmware":     "mixed",
+			},
+		},
+		{
+			description:   "unknown branch without version file or gsp support",
+			driverVersion: "400.300",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/driver.branch":      "r400",
+				"nvidia.com/driver.branch-type": "unknown",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			versionFile := filepath.Join(t.TempDir(), "version")
+			if tc.versionFile != "" {
+				require.NoError(t, os.WriteFile(versionFile, []byte(tc.versionFile), 0644))
+			}
+
+			manager := rt.NewManagerMockWithDevices(tc.devices...)
+			manager.GetDriverVersionFunc = func() (string, error) {
+				return tc.driverVersion, nil
+			}
+
+			l, err := newDriverLabeler(manager, &spec.Config{}, versionFile)
+			require.NoError(t, err)
+
+			labels, err := l.Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
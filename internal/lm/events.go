@@ -0,0 +1,112 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	k8s "github.com/NVIDIA/gpu-feature-discovery/internal/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// eventSourceComponent is the component reported as the source of the events created by GFD.
+const eventSourceComponent = "gpu-feature-discovery"
+
+// EventRecorder records events for the node we're running on.
+type EventRecorder interface {
+	Warning(reason string, message string)
+}
+
+// NewNodeEventRecorder creates a recorder for events of the node we're running on.
+// The recorder should be reused across labeling runs so that each event is only created once.
+func NewNodeEventRecorder() EventRecorder {
+	return &nodeEventRecorder{recorded: make(map[string]bool)}
+}
+
+// nodeEventRecorder creates Kubernetes events for the Node object.
+// Each event is only created once to avoid creating the same event on every labeling run.
+type nodeEventRecorder struct {
+	sync.Mutex
+	recorded map[string]bool
+}
+
+// Warning logs the warning and creates a Warning event for the node if it was not created before.
+// Errors creating the event (e.g. when not running in a cluster) are logged and otherwise ignored.
+func (r *nodeEventRecorder) Warning(reason string, message string) {
+	klog.Warningf("%s: %s", reason, message)
+
+	r.Lock()
+	defer r.Unlock()
+
+	key := reason + "/" + message
+	if r.recorded[key] {
+		return
+	}
+	if err := createNodeEvent(corev1.EventTypeWarning, reason, message); err != nil {
+		klog.Warningf("Unable to create node event: %v", err)
+		return
+	}
+	r.recorded[key] = true
+}
+
+// createNodeEvent creates an event for the node we're running on.
+func createNodeEvent(eventType string, reason string, message string) error {
+	cli, err := k8s.GetCoreRESTClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %v", err)
+	}
+
+	nodename := k8s.NodeName()
+	namespace := k8s.GetKubernetesNamespace()
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: nodename + ".",
+			Namespace:    namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Node",
+			Name:       nodename,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Count:          1,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Source: corev1.EventSource{
+			Component: eventSourceComponent,
+			Host:      nodename,
+		},
+	}
+
+	err = cli.Post().Namespace(namespace).Resource("events").Body(event).Do(context.TODO()).Error()
+	if err != nil {
+		return fmt.Errorf("failed to create event for Node %q: %w", nodename, err)
+	}
+	return nil
+}
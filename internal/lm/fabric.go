@@ -0,0 +1,74 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	"k8s.io/klog/v2"
+)
+
+// newFabricLabeler creates a labeler for the GPU fabric (multi-node NVLink) registration of the GPUs on the node.
+// The clique label is only generated once all GPUs have completed registration with the fabric manager and
+// belong to the same NVLink clique. No labels are generated if the GPU fabric is not supported.
+func newFabricLabeler(manager resource.Manager) (Labeler, error) {
+	devices, err := manager.GetDevices()
+	if err != nil {
+		return nil, err
+	}
+	if len(devices) == 0 {
+		// no devices, return empty labels
+		return empty{}, nil
+	}
+
+	var cliques []string
+	state, err := commonValue(devices, func(d resource.Device) (string, error) {
+		info, err := d.GetFabricInfo()
+		if err != nil {
+			return "", err
+		}
+		if info.State == resource.FabricStateCompleted {
+			cliques = append(cliques, fmt.Sprintf("%s.%d", info.ClusterUUID, info.CliqueID))
+		}
+		return info.State, nil
+	})
+	if errors.Is(err, resource.ErrNotSupported) {
+		return empty{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting GPU fabric info: %v", err)
+	}
+
+	labels := Labels{
+		"nvidia.com/gpu.fabric.state": state,
+	}
+	if state != resource.FabricStateCompleted {
+		return labels, nil
+	}
+
+	for _, clique := range cliques[1:] {
+		if clique != cliques[0] {
+			klog.Warning("GPUs belong to different NVLink cliques; not labeling clique")
+			return labels, nil
+		}
+	}
+	labels["nvidia.com/gpu.clique"] = cliques[0]
+
+	return labels, nil
+}
@@ -0,0 +1,105 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFabricLabeler(t *testing.T) {
+	completed := func(clique uint32) resource.FabricInfo {
+		return resource.FabricInfo{
+			State:       resource.FabricStateCompleted,
+			ClusterUUID: "7b8ba4ae-1d4b-4dc6-a3a5-8f1c1b3f2e41",
+			CliqueID:    clique,
+		}
+	}
+
+	testCases := []struct {
+		description    string
+		devices        []resource.Device
+		expectedLabels Labels
+	}{
+		{
+			description: "no devices returns empty labels",
+		},
+		{
+			description: "fabric not supported returns empty labels",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+			},
+		},
+		{
+			description: "registration completed returns clique",
+			devices: []resource.Device{
+				rt.NewDeviceMock(false).WithFabricInfo(completed(1)),
+				rt.NewDeviceMock(false).WithFabricInfo(completed(1)),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.fabric.state": "completed",
+				"nvidia.com/gpu.clique":       "7b8ba4ae-1d4b-4dc6-a3a5-8f1c1b3f2e41.1",
+			},
+		},
+		{
+			description: "registration in progress omits clique",
+			devices: []resource.Device{
+				rt.NewDeviceMock(false).WithFabricInfo(resource.FabricInfo{State: resource.FabricStateInProgress}),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.fabric.state": "in-progress",
+			},
+		},
+		{
+			description: "partially completed registration returns mixed",
+			devices: []resource.Device{
+				rt.NewDeviceMock(false).WithFabricInfo(completed(1)),
+				rt.NewDeviceMock(false).WithFabricInfo(resource.FabricInfo{State: resource.FabricStateNotStarted}),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.fabric.state": "mixed",
+			},
+		},
+		{
+			description: "different cliques omits clique",
+			devices: []resource.Device{
+				rt.NewDeviceMock(false).WithFabricInfo(completed(1)),
+				rt.NewDeviceMock(false).WithFabricInfo(completed(2)),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.fabric.state": "completed",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			manager := rt.NewManagerMockWithDevices(tc.devices...)
+
+			fabricLabeler, err := newFabricLabeler(manager)
+			require.NoError(t, err)
+
+			labels, err := fabricLabeler.Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// gdsModule is the kernel module required for GPUDirect Storage.
+	gdsModule = "nvidia_fs"
+	// mellanoxVendorID is the PCI vendor ID of the NVIDIA (Mellanox) networking adapters that support
+	// GPUDirect RDMA through the peer memory modules.
+	mellanoxVendorID = "0x15b3"
+)
+
+// gdrdmaModules are the kernel modules that provide GPUDirect RDMA: nvidia-peermem which ships with the
+// driver and the legacy nv_peer_mem module that ships with MOFED. Either one is sufficient.
+var gdrdmaModules = []string{"nvidia_peermem", "nv_peer_mem"}
+
+// gpuDirectLabeler detects GPUDirect Storage and GPUDirect RDMA support from the kernel modules and RDMA
+// devices of the host at the specified root.
+type gpuDirectLabeler struct {
+	root   string
+	config *spec.Config
+}
+
+// newGPUDirectLabeler creates a labeler for GPUDirect Storage and GPUDirect RDMA.
+// The gdsEnabled and mofedEnabled flags of the config are used to warn about missing kernel modules.
+func newGPUDirectLabeler(root string, config *spec.Config) Labeler {
+	return gpuDirectLabeler{
+		root:   root,
+		config: config,
+	}
+}
+
+// Labels generates the GPUDirect labels for the node.
+// GPUDirect RDMA is only considered enabled if at least one NVIDIA (Mellanox) RDMA device is present.
+func (l gpuDirectLabeler) Labels() (Labels, error) {
+	modules, err := l.getLoadedModules()
+	if err != nil {
+		return nil, fmt.Errorf("error getting loaded kernel modules: %v", err)
+	}
+
+	rdmaDevices, err := l.getRDMADevices()
+	if err != nil {
+		return nil, fmt.Errorf("error getting RDMA devices: %v", err)
+	}
+
+	gdrdmaModule := ""
+	for _, module := range gdrdmaModules {
+		if modules[module] {
+			gdrdmaModule = module
+			break
+		}
+	}
+
+	gdsEnabled := modules[gdsModule]
+	gdrdmaEnabled := gdrdmaModule != "" && len(rdmaDevices) > 0
+
+	if l.isRequested(l.gdsFlag()) && !gdsEnabled {
+		klog.Warningf("GPUDirect Storage is enabled in the config but the %v kernel module is not loaded", gdsModule)
+	}
+	if l.isRequested(l.mofedFlag()) && !gdrdmaEnabled {
+		klog.Warningf("MOFED is enabled in the config but GPUDirect RDMA is not available (peer memory module loaded: %v, RDMA devices: %d)", gdrdmaModule != "", len(rdmaDevices))
+	}
+
+	labels := Labels{
+		"nvidia.com/gds.enabled":    strconv.FormatBool(gdsEnabled),
+		"nvidia.com/gdrdma.enabled": strconv.FormatBool(gdrdmaEnabled),
+		"nvidia.com/gdrdma.nics":    strconv.Itoa(len(rdmaDevices)),
+	}
+	if version := l.getModuleVersion(gdsModule); gdsEnabled && version != "" {
+		labels["nvidia.com/gds.version"] = version
+	}
+	if version := l.getModuleVersion(gdrdmaModule); gdrdmaModule != "" && version != "" {
+		labels["nvidia.com/gdrdma.version"] = version
+	}
+
+	return labels, nil
+}
+
+func (l gpuDirectLabeler) gdsFlag() *bool {
+	if l.config == nil {
+		return nil
+	}
+	return l.config.Flags.GDSEnabled
+}
+
+func (l gpuDirectLabeler) mofedFlag() *bool {
+	if l.config == nil {
+		return nil
+	}
+	return l.config.Flags.MOFEDEnabled
+}
+
+func (l gpuDirectLabeler) isRequested(flag *bool) bool {
+	return flag != nil && *flag
+}
+
+// getLoadedModules returns the set of kernel modules listed in /proc/modules.
+// If the file does not exist, no modules are returned.
+func (l gpuDirectLabeler) getLoadedModules() (map[string]bool, error) {
+	f, err := os.Open(filepath.Join(l.root, "proc/modules"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	modules := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		modules[fields[0]] = true
+	}
+	return modules, scanner.Err()
+}
+
+// getModuleVersion returns the version of the specified kernel module from sysfs.
+// An empty string is returned if the version is not available.
+func (l gpuDirectLabeler) getModuleVersion(module string) string {
+	data, err := os.ReadFile(filepath.Join(l.root, "sys/module", module, "version"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// getRDMADevices returns the names of the RDMA devices registered in sysfs that are backed by an
+// NVIDIA (Mellanox) PCI device. Other RDMA devices (such as software RDMA devices) do not support
+// GPUDirect RDMA and are ignored.
+func (l gpuDirectLabeler) getRDMADevices() ([]string, error) {
+	classDir := filepath.Join(l.root, "sys/class/infiniband")
+	entries, err := os.ReadDir(classDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []string
+	for _, e := range entries {
+		vendor, err := os.ReadFile(filepath.Join(classDir, e.Name(), "device/vendor"))
+		if err != nil || strings.TrimSpace(string(vendor)) != mellanoxVendorID {
+			continue
+		}
+		devices = append(devices, e.Name())
+	}
+	return devices, nil
+}
@@ -0,0 +1,137 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGPUDirectLabeler(t *testing.T) {
+	testCases := []struct {
+		description    string
+		files          map[string]string
+		rdmaDevices    map[string]string
+		expectedLabels Labels
+	}{
+		{
+			description: "no modules loaded",
+			files: map[string]string{
+				"proc/modules": "nvidia 56500224 0 - Live 0x0000000000000000 (POE)\n",
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gds.enabled":    "false",
+				"nvidia.com/gdrdma.enabled": "false",
+				"nvidia.com/gdrdma.nics":    "0",
+			},
+		},
+		{
+			description: "gds and peermem loaded with rdma devices",
+			files: map[string]string{
+				"proc/modules": "nvidia_fs 258048 0 - Live 0x0000000000000000 (OE)\n" +
+					"nvidia_peermem 16384 0 - Live 0x0000000000000000 (OE)\n" +
+					"nvidia 56500224 2 nvidia_fs,nvidia_peermem, Live 0x0000000000000000 (POE)\n",
+				"sys/module/nvidia_fs/version":      "2.17.5\n",
+				"sys/module/nvidia_peermem/version": "535.104.05\n",
+			},
+			rdmaDevices: map[string]string{"mlx5_0": "0x15b3", "mlx5_1": "0x15b3"},
+			expectedLabels: Labels{
+				"nvidia.com/gds.enabled":    "true",
+				"nvidia.com/gds.version":    "2.17.5",
+				"nvidia.com/gdrdma.enabled": "true",
+				"nvidia.com/gdrdma.version": "535.104.05",
+				"nvidia.com/gdrdma.nics":    "2",
+			},
+		},
+		{
+			description: "peermem without rdma devices",
+			files: map[string]string{
+				"proc/modules":                      "nvidia_peermem 16384 0 - Live 0x0000000000000000 (OE)\n",
+				"sys/module/nvidia_peermem/version": "535.104.05\n",
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gds.enabled":    "false",
+				"nvidia.com/gdrdma.enabled": "false",
+				"nvidia.com/gdrdma.version": "535.104.05",
+				"nvidia.com/gdrdma.nics":    "0",
+			},
+		},
+		{
+			description: "legacy nv_peer_mem loaded with rdma devices",
+			files: map[string]string{
+				"proc/modules":                   "nv_peer_mem 16384 0 - Live 0x0000000000000000 (OE)\n",
+				"sys/module/nv_peer_mem/version": "1.3\n",
+			},
+			rdmaDevices: map[string]string{"mlx5_0": "0x15b3"},
+			expectedLabels: Labels{
+				"nvidia.com/gds.enabled":    "false",
+				"nvidia.com/gdrdma.enabled": "true",
+				"nvidia.com/gdrdma.version": "1.3",
+				"nvidia.com/gdrdma.nics":    "1",
+			},
+		},
+		{
+			description: "rdma devices of other vendors are not counted",
+			files: map[string]string{
+				"proc/modules":                      "nvidia_peermem 16384 0 - Live 0x0000000000000000 (OE)\n",
+				"sys/module/nvidia_peermem/version": "535.104.05\n",
+			},
+			rdmaDevices: map[string]string{"mlx5_0": "0x15b3", "irdma0": "0x8086", "rxe0": ""},
+			expectedLabels: Labels{
+				"nvidia.com/gds.enabled":    "false",
+				"nvidia.com/gdrdma.enabled": "true",
+				"nvidia.com/gdrdma.version": "535.104.05",
+				"nvidia.com/gdrdma.nics":    "1",
+			},
+		},
+		{
+			description: "missing proc modules",
+			expectedLabels: Labels{
+				"nvidia.com/gds.enabled":    "false",
+				"nvidia.com/gdrdma.enabled": "false",
+				"nvidia.com/gdrdma.nics":    "0",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			root := t.TempDir()
+			for name, contents := range tc.files {
+				path := filepath.Join(root, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+			}
+			for device, vendor := range tc.rdmaDevices {
+				dir := filepath.Join(root, "sys/class/infiniband", device)
+				require.NoError(t, os.MkdirAll(dir, 0755))
+				if vendor != "" {
+					require.NoError(t, os.MkdirAll(filepath.Join(dir, "device"), 0755))
+					require.NoError(t, os.WriteFile(filepath.Join(dir, "device/vendor"), []byte(vendor+"\n"), 0644))
+				}
+			}
+
+			labels, err := newGPUDirectLabeler(root, nil).Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
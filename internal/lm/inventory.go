@@ -0,0 +1,210 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	k8s "github.com/NVIDIA/gpu-feature-discovery/internal/kubernetes"
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// InventoryAnnotation is the annotation under which the device inventory is published.
+const InventoryAnnotation = "nvidia.com/gpu.inventory"
+
+// InventoryDevice holds the identifying information of a single device.
+type InventoryDevice struct {
+	UUID            string `json:"uuid,omitempty"`
+	Serial          string `json:"serial,omitempty"`
+	BoardPartNumber string `json:"boardPartNumber,omitempty"`
+	PCIBusID        string `json:"pciBusID,omitempty"`
+}
+
+// Inventory defines a type for the list of devices on a node
+type Inventory []InventoryDevice
+
+// NewInventory constructs the device inventory for the specified devices.
+// The devices are expected to belong to an initialized manager.
+// Properties that are not supported by a device are omitted.
+func NewInventory(devices []resource.Device) (Inventory, error) {
+	inventory := Inventory{}
+	for _, d := range devices {
+		var entry InventoryDevice
+		properties := []struct {
+			value *string
+			get   func() (string, error)
+		}{
+			{&entry.UUID, d.GetUUID},
+			{&entry.Serial, d.GetSerial},
+			{&entry.BoardPartNumber, d.GetBoardPartNumber},
+			{&entry.PCIBusID, d.GetPCIBusID},
+		}
+		for _, p := range properties {
+			value, err := p.get()
+			if errors.Is(err, resource.ErrNotSupported) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error getting device inventory: %v", err)
+			}
+			*p.value = strings.TrimSpace(value)
+		}
+		inventory = append(inventory, entry)
+	}
+
+	return inventory, nil
+}
+
+// String returns the compact JSON representation of the inventory.
+func (inventory Inventory) String() string {
+	output, err := json.Marshal(inventory)
+	if err != nil {
+		return ""
+	}
+	return string(output)
+}
+
+// InventoryPublisher publishes the device inventory as an annotation on the Node or NodeFeature object.
+// The inventory is collected while the labels are generated so that the initialized manager is reused.
+// The annotation is only updated if the inventory has changed since it was last published.
+type InventoryPublisher struct {
+	nodeFeatureAPI bool
+	inventory      Inventory
+	published      string
+}
+
+// NewInventoryPublisher creates a publisher for the device inventory.
+func NewInventoryPublisher(nodeFeatureAPI bool) *InventoryPublisher {
+	return &InventoryPublisher{nodeFeatureAPI: nodeFeatureAPI}
+}
+
+// Collect constructs the device inventory for the specified devices to be published by the next call to Publish.
+// If the inventory cannot be constructed, no inventory is published until it is collected successfully.
+func (p *InventoryPublisher) Collect(devices []resource.Device) error {
+	inventory, err := NewInventory(devices)
+	if err != nil {
+		p.inventory = nil
+		return err
+	}
+	p.inventory = inventory
+	return nil
+}
+
+// Publish updates the inventory annotation if the collected inventory has changed.
+func (p *InventoryPublisher) Publish() error {
+	if p.inventory == nil {
+		return fmt.Errorf("no device inventory collected")
+	}
+
+	value := p.inventory.String()
+	if value == p.published {
+		klog.Info("No changes in device inventory, not updating")
+		return nil
+	}
+
+	var err error
+	if p.nodeFeatureAPI {
+		klog.Info("Writing device inventory to NodeFeature CR")
+		err = updateNodeFeatureAnnotation(InventoryAnnotation, value)
+	} else {
+		klog.Info("Writing device inventory to Node")
+		err = updateNodeAnnotation(InventoryAnnotation, value)
+	}
+	if err != nil {
+		return err
+	}
+
+	p.published = value
+	return nil
+}
+
+// updateNodeFeatureAnnotation sets an annotation on the node-specific NodeFeature custom resource.
+// The NodeFeature object is expected to have been created when the labels were written.
+func updateNodeFeatureAnnotation(key string, value string) error {
+	cli, err := k8s.GetKubernetesClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %v", err)
+	}
+
+	namespace := k8s.GetKubernetesNamespace()
+	nodeFeatureName := strings.Join([]string{nodeFeatureVendorPrefix, k8s.NodeName()}, "-")
+
+	nfr, err := cli.NfdV1alpha1().NodeFeatures(namespace).Get(context.TODO(), nodeFeatureName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get NodeFeature object: %w", err)
+	}
+	if nfr.Annotations[key] == value {
+		klog.Infof("No changes in NodeFeature annotation %s, not updating", key)
+		return nil
+	}
+
+	nfrUpdated := nfr.DeepCopy()
+	if nfrUpdated.Annotations == nil {
+		nfrUpdated.Annotations = make(map[string]string)
+	}
+	nfrUpdated.Annotations[key] = value
+
+	_, err = cli.NfdV1alpha1().NodeFeatures(namespace).Update(context.TODO(), nfrUpdated, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update NodeFeature object %q: %w", nfr.Name, err)
+	}
+	return nil
+}
+
+// updateNodeAnnotation sets an annotation on the node we're running on.
+func updateNodeAnnotation(key string, value string) error {
+	cli, err := k8s.GetCoreRESTClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %v", err)
+	}
+
+	nodename := k8s.NodeName()
+
+	node := &corev1.Node{}
+	err = cli.Get().Resource("nodes").Name(nodename).Do(context.TODO()).Into(node)
+	if err != nil {
+		return fmt.Errorf("failed to get Node object %q: %w", nodename, err)
+	}
+	if node.Annotations[key] == value {
+		klog.Infof("No changes in Node annotation %s, not updating", key)
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{key: value},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to construct Node patch: %v", err)
+	}
+
+	err = cli.Patch(types.MergePatchType).Resource("nodes").Name(nodename).Body(patch).Do(context.TODO()).Error()
+	if err != nil {
+		return fmt.Errorf("failed to patch Node object %q: %w", nodename, err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventory(t *testing.T) {
+	unsupported := rt.NewFullGPU().(*rt.DeviceMock).WithUUID("GPU-2")
+	unsupported.GetSerialFunc = func() (string, error) { return "", resource.ErrNotSupported }
+	unsupported.GetBoardPartNumberFunc = func() (string, error) { return "", resource.ErrNotSupported }
+
+	testCases := []struct {
+		description       string
+		devices           []resource.Device
+		expectedInventory Inventory
+		expectedString    string
+	}{
+		{
+			description:       "no devices returns empty inventory",
+			expectedInventory: Inventory{},
+			expectedString:    `[]`,
+		},
+		{
+			description: "single device",
+			devices: []resource.Device{
+				rt.NewDeviceMock(false).WithUUID("GPU-1"),
+			},
+			expectedInventory: Inventory{
+				{
+					UUID:            "GPU-1",
+					Serial:          "0000000000000",
+					BoardPartNumber: "000-00000-0000-000",
+					PCIBusID:        "00000000:00:00.0",
+				},
+			},
+			expectedString: `[{"uuid":"GPU-1","serial":"0000000000000","boardPartNumber":"000-00000-0000-000","pciBusID":"00000000:00:00.0"}]`,
+		},
+		{
+			description: "unsupported properties are omitted",
+			devices: []resource.Device{
+				unsupported,
+			},
+			expectedInventory: Inventory{
+				{
+					UUID:     "GPU-2",
+					PCIBusID: "00000000:00:00.0",
+				},
+			},
+			expectedString: `[{"uuid":"GPU-2","pciBusID":"00000000:00:00.0"}]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			inventory, err := NewInventory(tc.devices)
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedInventory, inventory)
+			require.Equal(t, tc.expectedString, inventory.String())
+		})
+	}
+}
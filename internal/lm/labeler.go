@@ -18,27 +18,54 @@ package lm
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
 	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
 	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
 )
 
+// hostRoot returns the path at which the host filesystem is available as set in the config.
+// The sysfs and procfs files read by the labelers are resolved below this root.
+func hostRoot(config *spec.Config) string {
+	if config.Flags.GFD == nil || config.Flags.GFD.HostRoot == nil {
+		return "/"
+	}
+	return *config.Flags.GFD.HostRoot
+}
+
+// hostPath returns the specified path of the host filesystem below the host root of the config.
+// An empty path is returned unchanged.
+func hostPath(config *spec.Config, path string) string {
+	if path == "" {
+		return ""
+	}
+	return filepath.Join(hostRoot(config), path)
+}
+
 // Labeler defines an interface for generating labels
 type Labeler interface {
 	Labels() (Labels, error)
 }
 
-// NewLabelers constructs the required labelers from the specified config
-func NewLabelers(manager resource.Manager, vgpu vgpu.Interface, config *spec.Config) (Labeler, error) {
-	nvmlLabeler, err := NewNVMLLabeler(manager, config)
+// NewLabelers constructs the required labelers from the specified config.
+// If an inventory publisher is specified, the device inventory is collected for it at the same time.
+// Warnings that need the attention of the cluster administrator are recorded as events for the node.
+func NewLabelers(manager resource.Manager, vgpuLib vgpu.Interface, config *spec.Config, inventory *InventoryPublisher, events EventRecorder) (Labeler, error) {
+	nvmlLabeler, err := NewNVMLLabeler(manager, config, inventory)
 	if err != nil {
 		return nil, fmt.Errorf("error creating NVML labeler: %v", err)
 	}
 
+	virtualization, err := detectVirtualization(manager, vgpuLib, vgpu.NewNvidiaPCILib(hostRoot(config)), hostPath(config, dmiRoot))
+	if err != nil {
+		return nil, fmt.Errorf("error detecting virtualization: %v", err)
+	}
+
 	l := Merge(
 		nvmlLabeler,
-		NewVGPULabeler(vgpu),
+		newVirtualizationModeLabeler(virtualization),
+		newVGPULabeler(virtualization, events),
 	)
 
 	return l, nil
@@ -0,0 +1,64 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/mig"
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+)
+
+// newMigProfileLabeler creates a labeler for the catalog of MIG profiles supported by the MIG-capable GPUs
+// on the node. For each profile, the maximum number of instances that can be created on a single GPU
+// is published as nvidia.com/mig.profile.<profile>.max. The labels for GPU instance profiles are generated
+// irrespective of whether MIG is enabled; compute instance profiles are only listed for the GPU instance
+// profiles of which an instance exists.
+// For MIG-enabled GPUs, the number of additional instances of each profile that can still be created
+// across all GPUs is published as nvidia.com/mig.profile.<profile>.available.
+func newMigProfileLabeler(manager resource.Manager) (Labeler, error) {
+	devices, err := manager.GetDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(Labels)
+	maxInstances := make(map[string]int)
+	for _, d := range devices {
+		profiles, err := d.GetSupportedMigProfiles()
+		if err != nil {
+			return nil, fmt.Errorf("error getting supported MIG profiles: %v", err)
+		}
+		for _, p := range profiles {
+			if p.MaxInstances <= maxInstances[p.Name] {
+				continue
+			}
+			maxInstances[p.Name] = p.MaxInstances
+			labels[fmt.Sprintf("nvidia.com/mig.profile.%s.max", p.Name)] = fmt.Sprintf("%d", p.MaxInstances)
+		}
+	}
+
+	remaining, err := mig.NewDeviceInfo(manager).GetRemainingMigCapacity()
+	if err != nil {
+		return nil, fmt.Errorf("error getting remaining MIG capacity: %v", err)
+	}
+	for profile, count := range remaining {
+		labels[fmt.Sprintf("nvidia.com/mig.profile.%s.available", profile)] = fmt.Sprintf("%d", count)
+	}
+
+	return labels, nil
+}
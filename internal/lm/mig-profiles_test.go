@@ -0,0 +1,120 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigProfileLabeler(t *testing.T) {
+	a100Profiles := []resource.MigProfile{
+		{Name: "1g.10gb", MaxInstances: 7},
+		{Name: "1g.10gb.me", MaxInstances: 1},
+		{Name: "2g.20gb", MaxInstances: 3},
+		{Name: "3g.40gb", MaxInstances: 2},
+		{Name: "7g.80gb", MaxInstances: 1},
+		{Name: "1c.3g.40gb", MaxInstances: 6},
+		{Name: "2c.3g.40gb", MaxInstances: 2},
+		{Name: "1c.7g.80gb", MaxInstances: 7},
+	}
+
+	testCases := []struct {
+		description    string
+		devices        []resource.Device
+		expectedLabels Labels
+	}{
+		{
+			description:    "no devices returns empty labels",
+			expectedLabels: Labels{},
+		},
+		{
+			description: "device that is not MIG capable returns empty labels",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+			},
+			expectedLabels: Labels{},
+		},
+		{
+			description: "MIG capable device with MIG disabled returns catalog",
+			devices: []resource.Device{
+				rt.NewDeviceMock(false).WithSupportedMigProfiles(a100Profiles...),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/mig.profile.1g.10gb.max":    "7",
+				"nvidia.com/mig.profile.1g.10gb.me.max": "1",
+				"nvidia.com/mig.profile.2g.20gb.max":    "3",
+				"nvidia.com/mig.profile.3g.40gb.max":    "2",
+				"nvidia.com/mig.profile.7g.80gb.max":    "1",
+				"nvidia.com/mig.profile.1c.3g.40gb.max": "6",
+				"nvidia.com/mig.profile.2c.3g.40gb.max": "2",
+				"nvidia.com/mig.profile.1c.7g.80gb.max": "7",
+			},
+		},
+		{
+			description: "catalog of multiple devices is merged",
+			devices: []resource.Device{
+				rt.NewMigEnabledDevice().(*rt.DeviceMock).WithSupportedMigProfiles(a100Profiles[0], a100Profiles[4]),
+				rt.NewDeviceMock(false).WithSupportedMigProfiles(
+					resource.MigProfile{Name: "1g.5gb", MaxInstances: 7},
+					resource.MigProfile{Name: "7g.80gb", MaxInstances: 1},
+				),
+				rt.NewFullGPU(),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/mig.profile.1g.5gb.max":  "7",
+				"nvidia.com/mig.profile.1g.10gb.max": "7",
+				"nvidia.com/mig.profile.7g.80gb.max": "1",
+			},
+		},
+		{
+			description: "MIG-enabled devices return remaining capacity",
+			devices: []resource.Device{
+				rt.NewMigEnabledDevice(rt.NewMigDevice(3, 3, 40)).(*rt.DeviceMock).
+					WithSupportedMigProfiles(a100Profiles[0], a100Profiles[3]).
+					WithRemainingMigCapacity(map[string]int{"1g.10gb": 3, "3g.40gb": 1}),
+				rt.NewMigEnabledDevice().(*rt.DeviceMock).
+					WithSupportedMigProfiles(a100Profiles[0], a100Profiles[3]).
+					WithRemainingMigCapacity(map[string]int{"1g.10gb": 7, "3g.40gb": 2}),
+				rt.NewDeviceMock(false).WithSupportedMigProfiles(a100Profiles[0], a100Profiles[3]),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/mig.profile.1g.10gb.max":       "7",
+				"nvidia.com/mig.profile.3g.40gb.max":       "2",
+				"nvidia.com/mig.profile.1g.10gb.available": "10",
+				"nvidia.com/mig.profile.3g.40gb.available": "3",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			manager := rt.NewManagerMockWithDevices(tc.devices...)
+
+			migProfileLabeler, err := newMigProfileLabeler(manager)
+			require.NoError(t, err)
+
+			labels, err := migProfileLabeler.Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
@@ -30,6 +30,14 @@ const (
 	MigStrategyNone   = "none"
 	MigStrategySingle = "single"
 	MigStrategyMixed  = "mixed"
+	MigStrategyAuto   = "auto"
+)
+
+// Reasons for an invalid MIG strategy as published in the nvidia.com/mig.strategy.reason label.
+const (
+	MigStrategyReasonEmptyMigDevice      = "empty-mig-device"
+	MigStrategyReasonMixedMigMode        = "mixed-mig-mode"
+	MigStrategyReasonMultipleMigProfiles = "multiple-mig-profiles"
 )
 
 // migResource is used to track MIG devices for labelling under the single and mixed strategies.
@@ -80,11 +88,21 @@ func NewResourceLabeler(manager resource.Manager, config *spec.Config) (Labeler,
 type MigDeviceCounts map[string]int
 
 // newMigLabeler creates a labeler for MIG devices.
-// The labeler created depends on the migStrategy.
+// The labeler created depends on the migStrategy. For the auto strategy, the
+// effective strategy is determined from the devices on the node.
 func newMigLabeler(manager resource.Manager, config *spec.Config) (Labeler, error) {
 	var err error
+	strategy := *config.Flags.MigStrategy
+	if strategy == MigStrategyAuto {
+		strategy, err = getAutoMigStrategy(mig.NewDeviceInfo(manager))
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine mig-strategy for mig-strategy=auto: %v", err)
+		}
+		klog.Infof("Using mig-strategy=%v for mig-strategy=auto", strategy)
+	}
+
 	var labeler Labeler
-	switch *config.Flags.MigStrategy {
+	switch strategy {
 	case MigStrategyNone:
 		labeler = empty{}
 	case MigStrategySingle:
@@ -101,14 +119,72 @@ func newMigLabeler(manager resource.Manager, config *spec.Config) (Labeler, erro
 		return nil, fmt.Errorf("unknown strategy: %v", *config.Flags.MigStrategy)
 	}
 
+	strategyLabeler := migStrategyLabeler(strategy)
+	if *config.Flags.MigStrategy == MigStrategyAuto {
+		// The effective strategy is always published for the auto strategy, including none.
+		strategyLabeler = Labels{
+			"nvidia.com/mig.strategy":        strategy,
+			"nvidia.com/mig.strategy.status": migStrategyStatusValid,
+		}
+	}
+
 	labelers := Merge(
-		migStrategyLabeler(*config.Flags.MigStrategy),
+		strategyLabeler,
 		labeler,
 	)
 
 	return labelers, nil
 }
 
+// getAutoMigStrategy determines the MIG strategy to use for the devices on the node:
+//   - none if MIG is disabled on all devices
+//   - single if MIG is enabled on all devices and all MIG devices have the same profile
+//   - mixed otherwise
+func getAutoMigStrategy(deviceInfo *mig.DeviceInfo) (string, error) {
+	migEnabledDevices, err := deviceInfo.GetDevicesWithMigEnabled()
+	if err != nil {
+		return "", fmt.Errorf("unabled to retrieve list of MIG-enabled devices: %v", err)
+	}
+	if len(migEnabledDevices) == 0 {
+		return MigStrategyNone, nil
+	}
+
+	migDisabledDevices, err := deviceInfo.GetDevicesWithMigDisabled()
+	if err != nil {
+		return "", fmt.Errorf("unabled to retrieve list of non-MIG-enabled devices: %v", err)
+	}
+	if len(migDisabledDevices) != 0 {
+		return MigStrategyMixed, nil
+	}
+
+	hasEmpty, err := deviceInfo.AnyMigEnabledDeviceIsEmpty()
+	if err != nil {
+		return "", fmt.Errorf("failed to check for empty MIG-enabled devices: %v", err)
+	}
+	if hasEmpty {
+		return MigStrategyMixed, nil
+	}
+
+	migs, err := deviceInfo.GetAllMigDevices()
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve list of MIG devices: %v", err)
+	}
+
+	profiles := make(map[string]bool)
+	for _, mig := range migs {
+		name, err := mig.GetName()
+		if err != nil {
+			return "", fmt.Errorf("unable to get MIG device name: %v", err)
+		}
+		profiles[name] = true
+	}
+	if len(profiles) != 1 {
+		return MigStrategyMixed, nil
+	}
+
+	return MigStrategySingle, nil
+}
+
 // newGPULabelers creates a set of labelers for full GPUs
 func newGPULabelers(manager resource.Manager, config *spec.Config) (Labeler, error) {
 	deviceInfo := mig.NewDeviceInfo(manager)
@@ -189,22 +265,33 @@ func newMigStrategySingleLabeler(manager resource.Manager, config *spec.Config)
 		return empty{}, nil
 	}
 
+	migDisabledDevices, err := deviceInfo.GetDevicesWithMigDisabled()
+	if err != nil {
+		return nil, fmt.Errorf("unabled to retrieve list of non-MIG-enabled devices: %v", err)
+	}
+
 	hasEmpty, err := deviceInfo.AnyMigEnabledDeviceIsEmpty()
 	if err != nil {
 		return nil, fmt.Errorf("failed to check for empty MIG-enabled devices: %v", err)
 	}
+	// The labels for GPUs with MIG disabled are kept instead of being replaced by the mig-strategy-invalid
+	// labels if this is requested in the config.
+	keepFullGPULabels := config.Flags.GFD != nil && config.Flags.GFD.KeepFullGPULabels != nil && *config.Flags.GFD.KeepFullGPULabels
+
 	// If any migEnabled=true device is empty, we return the set of mig-strategy-invalid labels.
 	if hasEmpty {
-		return newInvalidMigStrategyLabeler(migEnabledDevices[0], "at least one MIG device is enabled but empty")
+		if keepFullGPULabels && len(migDisabledDevices) != 0 {
+			return newInvalidMigStrategyFullGPULabeler(config, migDisabledDevices, MigStrategyReasonEmptyMigDevice, "at least one MIG device is enabled but empty")
+		}
+		return newInvalidMigStrategyLabeler(migEnabledDevices[0], MigStrategyReasonEmptyMigDevice, "at least one MIG device is enabled but empty")
 	}
 
-	migDisabledDevices, err := deviceInfo.GetDevicesWithMigDisabled()
-	if err != nil {
-		return nil, fmt.Errorf("unabled to retrieve list of non-MIG-enabled devices: %v", err)
-	}
 	// If we have a mix of mig-enabled and mig-disabled device we return the set of mig-strategy-invalid labels
 	if len(migDisabledDevices) != 0 {
-		return newInvalidMigStrategyLabeler(migEnabledDevices[0], "devices with MIG enabled and disable detected")
+		if keepFullGPULabels {
+			return newInvalidMigStrategyFullGPULabeler(config, migDisabledDevices, MigStrategyReasonMixedMigMode, "devices with MIG enabled and disable detected")
+		}
+		return newInvalidMigStrategyLabeler(migEnabledDevices[0], MigStrategyReasonMixedMigMode, "devices with MIG enabled and disable detected")
 	}
 
 	migs, err := deviceInfo.GetAllMigDevices()
@@ -234,14 +321,14 @@ func newMigStrategySingleLabeler(manager resource.Manager, config *spec.Config)
 
 	// Multiple resources mean that we have more than one MIG profile defined. Return the set of mig-strategy-invalid labels.
 	if len(resources) != 1 {
-		return newInvalidMigStrategyLabeler(migEnabledDevices[0], "more than one MIG device type present on node")
+		return newInvalidMigStrategyLabeler(migEnabledDevices[0], MigStrategyReasonMultipleMigProfiles, "more than one MIG device type present on node")
 	}
 
 	return newMIGDeviceLabelers(resources, config)
 }
 
-func newInvalidMigStrategyLabeler(device resource.Device, reason string) (Labeler, error) {
-	klog.Warningf("Invalid configuration detected for mig-strategy=single: %v", reason)
+func newInvalidMigStrategyLabeler(device resource.Device, reason string, message string) (Labeler, error) {
+	klog.Warningf("Invalid configuration detected for mig-strategy=single: %v", message)
 
 	model, err := device.GetName()
 	if err != nil {
@@ -258,9 +345,49 @@ func newInvalidMigStrategyLabeler(device resource.Device, reason string) (Labele
 	rl.updateLabel(labels, "replicas", 0)
 	rl.updateLabel(labels, "memory", 0)
 
+	for k, v := range invalidMigStrategyStatusLabels(reason) {
+		labels[k] = v
+	}
+
 	return labels, nil
 }
 
+// newInvalidMigStrategyFullGPULabeler creates a labeler for an invalid mig-strategy=single configuration
+// that keeps the labels for the specified GPUs with MIG disabled.
+func newInvalidMigStrategyFullGPULabeler(config *spec.Config, migDisabledDevices []resource.Device, reason string, message string) (Labeler, error) {
+	klog.Warningf("Invalid configuration detected for mig-strategy=single: %v; keeping labels for GPUs with MIG disabled", message)
+
+	counts := make(map[string]int)
+	fullGPUs := make(map[string]resource.Device)
+	for _, device := range migDisabledDevices {
+		name, err := device.GetName()
+		if err != nil {
+			return nil, fmt.Errorf("error getting device name: %v", err)
+		}
+		fullGPUs[name] = device
+		counts[name]++
+	}
+
+	labelers := list{invalidMigStrategyStatusLabels(reason)}
+	for name, fullGPU := range fullGPUs {
+		l, err := NewGPUResourceLabeler(config, fullGPU, counts[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to construct labeler: %v", err)
+		}
+		labelers = append(labelers, l)
+	}
+
+	return labelers, nil
+}
+
+// invalidMigStrategyStatusLabels creates the MIG strategy status labels for an invalid configuration.
+func invalidMigStrategyStatusLabels(reason string) Labels {
+	return Labels{
+		"nvidia.com/mig.strategy.status": migStrategyStatusInvalid,
+		"nvidia.com/mig.strategy.reason": reason,
+	}
+}
+
 func newMigStrategyMixedLabeler(manager resource.Manager, config *spec.Config) (Labeler, error) {
 	deviceInfo := mig.NewDeviceInfo(manager)
 
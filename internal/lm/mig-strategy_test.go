@@ -42,13 +42,17 @@ func TestMigStrategyNoneLabels(t *testing.T) {
 				rt.NewFullGPU(),
 			},
 			expectedLabels: Labels{
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.count":         "1",
-				"nvidia.com/gpu.replicas":      "1",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "1",
+				"nvidia.com/gpu.replicas":             "1",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL",
 			},
 		},
 		{
@@ -65,13 +69,17 @@ func TestMigStrategyNoneLabels(t *testing.T) {
 				},
 			},
 			expectedLabels: Labels{
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.count":         "1",
-				"nvidia.com/gpu.replicas":      "2",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL-SHARED",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "1",
+				"nvidia.com/gpu.replicas":             "2",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL-SHARED",
 			},
 		},
 		{
@@ -89,13 +97,17 @@ func TestMigStrategyNoneLabels(t *testing.T) {
 				},
 			},
 			expectedLabels: Labels{
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.count":         "2",
-				"nvidia.com/gpu.replicas":      "2",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL-SHARED",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "2",
+				"nvidia.com/gpu.replicas":             "2",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL-SHARED",
 			},
 		},
 		{
@@ -154,13 +166,17 @@ func TestMigStrategyNoneLabels(t *testing.T) {
 				},
 			},
 			expectedLabels: Labels{
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.count":         "2",
-				"nvidia.com/gpu.replicas":      "2",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL-SHARED",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "2",
+				"nvidia.com/gpu.replicas":             "2",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL-SHARED",
 			},
 		},
 	}
@@ -201,6 +217,7 @@ func TestMigStrategySingleLabels(t *testing.T) {
 		expectedError  bool
 		expectedLabels Labels
 		isInvalid      bool
+		keepFullGPUs   bool
 	}{
 		{
 			description: "no devices returns empty labels",
@@ -211,14 +228,19 @@ func TestMigStrategySingleLabels(t *testing.T) {
 				rt.NewFullGPU(),
 			},
 			expectedLabels: Labels{
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.count":         "1",
-				"nvidia.com/gpu.replicas":      "1",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL",
-				"nvidia.com/mig.strategy":      "single",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "1",
+				"nvidia.com/gpu.replicas":             "1",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL",
+				"nvidia.com/mig.strategy":             "single",
+				"nvidia.com/mig.strategy.status":      "valid",
 			},
 		},
 		{
@@ -228,14 +250,19 @@ func TestMigStrategySingleLabels(t *testing.T) {
 				rt.NewFullGPU(),
 			},
 			expectedLabels: Labels{
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.count":         "2",
-				"nvidia.com/gpu.replicas":      "1",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL",
-				"nvidia.com/mig.strategy":      "single",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "2",
+				"nvidia.com/gpu.replicas":             "1",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL",
+				"nvidia.com/mig.strategy":             "single",
+				"nvidia.com/mig.strategy.status":      "valid",
 			},
 		},
 		{
@@ -251,6 +278,7 @@ func TestMigStrategySingleLabels(t *testing.T) {
 				"nvidia.com/gpu.memory":          "100",
 				"nvidia.com/gpu.product":         "MOCKMODEL-MIG-1g.100gb",
 				"nvidia.com/mig.strategy":        "single",
+				"nvidia.com/mig.strategy.status": "valid",
 				"nvidia.com/gpu.multiprocessors": "0",
 				"nvidia.com/gpu.slices.gi":       "1",
 				"nvidia.com/gpu.slices.ci":       "2",
@@ -291,6 +319,7 @@ func TestMigStrategySingleLabels(t *testing.T) {
 				"nvidia.com/gpu.memory":          "100",
 				"nvidia.com/gpu.product":         "MOCKMODEL-MIG-1g.100gb",
 				"nvidia.com/mig.strategy":        "single",
+				"nvidia.com/mig.strategy.status": "valid",
 				"nvidia.com/gpu.multiprocessors": "12",
 				"nvidia.com/gpu.slices.gi":       "1",
 				"nvidia.com/gpu.slices.ci":       "2",
@@ -308,11 +337,13 @@ func TestMigStrategySingleLabels(t *testing.T) {
 			},
 			isInvalid: true,
 			expectedLabels: Labels{
-				"nvidia.com/gpu.count":    "0",
-				"nvidia.com/gpu.replicas": "0",
-				"nvidia.com/gpu.memory":   "0",
-				"nvidia.com/gpu.product":  "MOCKMODEL-MIG-INVALID",
-				"nvidia.com/mig.strategy": "single",
+				"nvidia.com/gpu.count":           "0",
+				"nvidia.com/gpu.replicas":        "0",
+				"nvidia.com/gpu.memory":          "0",
+				"nvidia.com/gpu.product":         "MOCKMODEL-MIG-INVALID",
+				"nvidia.com/mig.strategy":        "single",
+				"nvidia.com/mig.strategy.status": "invalid",
+				"nvidia.com/mig.strategy.reason": MigStrategyReasonEmptyMigDevice,
 			},
 		},
 		{
@@ -325,11 +356,13 @@ func TestMigStrategySingleLabels(t *testing.T) {
 			},
 			isInvalid: true,
 			expectedLabels: Labels{
-				"nvidia.com/gpu.count":    "0",
-				"nvidia.com/gpu.replicas": "0",
-				"nvidia.com/gpu.memory":   "0",
-				"nvidia.com/gpu.product":  "MOCKMODEL-MIG-INVALID",
-				"nvidia.com/mig.strategy": "single",
+				"nvidia.com/gpu.count":           "0",
+				"nvidia.com/gpu.replicas":        "0",
+				"nvidia.com/gpu.memory":          "0",
+				"nvidia.com/gpu.product":         "MOCKMODEL-MIG-INVALID",
+				"nvidia.com/mig.strategy":        "single",
+				"nvidia.com/mig.strategy.status": "invalid",
+				"nvidia.com/mig.strategy.reason": MigStrategyReasonMultipleMigProfiles,
 			},
 		},
 		{
@@ -342,14 +375,20 @@ func TestMigStrategySingleLabels(t *testing.T) {
 			},
 			isInvalid: true,
 			expectedLabels: Labels{
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.count":         "0",
-				"nvidia.com/gpu.replicas":      "0",
-				"nvidia.com/gpu.memory":        "0",
-				"nvidia.com/gpu.product":       "MOCKMODEL-MIG-INVALID",
-				"nvidia.com/mig.strategy":      "single",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "0",
+				"nvidia.com/gpu.replicas":             "0",
+				"nvidia.com/gpu.memory":               "0",
+				"nvidia.com/gpu.product":              "MOCKMODEL-MIG-INVALID",
+				"nvidia.com/mig.strategy":             "single",
+				"nvidia.com/mig.strategy.status":      "invalid",
+				"nvidia.com/mig.strategy.reason":      MigStrategyReasonMixedMigMode,
 			},
 		},
 		{
@@ -363,14 +402,73 @@ func TestMigStrategySingleLabels(t *testing.T) {
 			},
 			isInvalid: true,
 			expectedLabels: Labels{
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.count":         "0",
-				"nvidia.com/gpu.replicas":      "0",
-				"nvidia.com/gpu.memory":        "0",
-				"nvidia.com/gpu.product":       "MOCKMODEL-MIG-INVALID",
-				"nvidia.com/mig.strategy":      "single",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "0",
+				"nvidia.com/gpu.replicas":             "0",
+				"nvidia.com/gpu.memory":               "0",
+				"nvidia.com/gpu.product":              "MOCKMODEL-MIG-INVALID",
+				"nvidia.com/mig.strategy":             "single",
+				"nvidia.com/mig.strategy.status":      "invalid",
+				"nvidia.com/mig.strategy.reason":      MigStrategyReasonEmptyMigDevice,
+			},
+		},
+		{
+			description: "mixed mig enabled and disabled keeps full GPU labels if requested",
+			devices: []resource.Device{
+				rt.NewMigEnabledDevice(
+					rt.NewMigDevice(1, 2, 100),
+				),
+				rt.NewFullGPU(),
+				rt.NewFullGPU(),
+			},
+			isInvalid:    true,
+			keepFullGPUs: true,
+			expectedLabels: Labels{
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "2",
+				"nvidia.com/gpu.replicas":             "1",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL",
+				"nvidia.com/mig.strategy":             "single",
+				"nvidia.com/mig.strategy.status":      "invalid",
+				"nvidia.com/mig.strategy.reason":      MigStrategyReasonMixedMigMode,
+			},
+		},
+		{
+			description: "empty mig enabled and disabled keeps full GPU labels if requested",
+			devices: []resource.Device{
+				rt.NewMigEnabledDevice(),
+				rt.NewFullGPU(),
+			},
+			isInvalid:    true,
+			keepFullGPUs: true,
+			expectedLabels: Labels{
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.count":                "1",
+				"nvidia.com/gpu.replicas":             "1",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL",
+				"nvidia.com/mig.strategy":             "single",
+				"nvidia.com/mig.strategy.status":      "invalid",
+				"nvidia.com/mig.strategy.reason":      MigStrategyReasonEmptyMigDevice,
 			},
 		},
 	}
@@ -383,6 +481,9 @@ func TestMigStrategySingleLabels(t *testing.T) {
 				Flags: spec.Flags{
 					CommandLineFlags: spec.CommandLineFlags{
 						MigStrategy: ptr(MigStrategySingle),
+						GFD: &spec.GFDCommandLineFlags{
+							KeepFullGPULabels: ptr(tc.keepFullGPUs),
+						},
 					},
 				},
 			}
@@ -405,3 +506,110 @@ func TestMigStrategySingleLabels(t *testing.T) {
 func ptr[T any](x T) *T {
 	return &x
 }
+
+func TestMigStrategyAutoLabels(t *testing.T) {
+	testCases := []struct {
+		description    string
+		devices        []resource.Device
+		expectedLabels Labels
+	}{
+		{
+			description: "mig disabled everywhere selects none",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+				rt.NewFullGPU(),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/mig.strategy":        "none",
+				"nvidia.com/mig.strategy.status": "valid",
+				"nvidia.com/gpu.product":         "MOCKMODEL",
+				"nvidia.com/gpu.count":           "2",
+			},
+		},
+		{
+			description: "uniform mig devices selects single",
+			devices: []resource.Device{
+				rt.NewMigEnabledDevice(
+					rt.NewMigDevice(1, 1, 5),
+					rt.NewMigDevice(1, 1, 5),
+				),
+				rt.NewMigEnabledDevice(
+					rt.NewMigDevice(1, 1, 5),
+				),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/mig.strategy": "single",
+				"nvidia.com/gpu.product":  "MOCKMODEL-MIG-1g.5gb",
+				"nvidia.com/gpu.count":    "3",
+			},
+		},
+		{
+			description: "different mig profiles selects mixed",
+			devices: []resource.Device{
+				rt.NewMigEnabledDevice(
+					rt.NewMigDevice(1, 1, 5),
+					rt.NewMigDevice(3, 3, 20),
+				),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/mig.strategy":       "mixed",
+				"nvidia.com/gpu.product":        "MOCKMODEL",
+				"nvidia.com/mig-1g.5gb.count":   "1",
+				"nvidia.com/mig-3g.20gb.count":  "1",
+				"nvidia.com/mig-1g.5gb.product": "MOCKMODEL-MIG-1g.5gb",
+			},
+		},
+		{
+			description: "mig enabled and disabled devices selects mixed",
+			devices: []resource.Device{
+				rt.NewFullGPU(),
+				rt.NewMigEnabledDevice(
+					rt.NewMigDevice(1, 1, 5),
+				),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/mig.strategy":     "mixed",
+				"nvidia.com/gpu.product":      "MOCKMODEL",
+				"nvidia.com/gpu.count":        "2",
+				"nvidia.com/mig-1g.5gb.count": "1",
+			},
+		},
+		{
+			description: "empty mig-enabled device selects mixed",
+			devices: []resource.Device{
+				rt.NewMigEnabledDevice(
+					rt.NewMigDevice(1, 1, 5),
+				),
+				rt.NewMigEnabledDevice(),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/mig.strategy":     "mixed",
+				"nvidia.com/mig-1g.5gb.count": "1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlMock := rt.NewManagerMockWithDevices(tc.devices...)
+
+			config := spec.Config{
+				Flags: spec.Flags{
+					CommandLineFlags: spec.CommandLineFlags{
+						MigStrategy: ptr(MigStrategyAuto),
+					},
+				},
+			}
+
+			auto, err := NewResourceLabeler(nvmlMock, &config)
+			require.NoError(t, err)
+
+			labels, err := auto.Labels()
+			require.NoError(t, err)
+
+			for key, value := range tc.expectedLabels {
+				require.Equal(t, value, labels[key], key)
+			}
+		})
+	}
+}
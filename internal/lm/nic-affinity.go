@@ -0,0 +1,88 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"strconv"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
+	"k8s.io/klog/v2"
+)
+
+// newNICAffinityLabeler creates a labeler for the PCIe affinity between the NVIDIA GPUs and the RDMA-capable
+// NICs on the node. A GPU has a local NIC if the nearest upstream bridge common to the two is a PCIe switch
+// port, i.e. traffic between them does not cross a root port. If the PCI devices cannot be read or no NVIDIA GPUs are found, no labels are generated.
+func newNICAffinityLabeler(pci vgpu.NvidiaPCI) (Labeler, error) {
+	devices, err := pci.Devices()
+	if err != nil {
+		klog.Warningf("Unable to get PCI devices for NIC affinity labels: %v", err)
+		return empty{}, nil
+	}
+
+	var gpus, nics []*vgpu.PCIDevice
+	for _, d := range devices {
+		switch {
+		case d.Vendor == vgpu.PciNvidiaVendorID && d.Class == vgpu.PciDisplayControllerClass:
+			gpus = append(gpus, d)
+		case d.Class == vgpu.PciNetworkControllerClass && d.IsRDMACapable():
+			nics = append(nics, d)
+		}
+	}
+	if len(gpus) == 0 {
+		return empty{}, nil
+	}
+
+	var nicParents [][]string
+	for _, nic := range nics {
+		parents, err := nic.GetParentAddresses()
+		if err != nil {
+			return nil, err
+		}
+		nicParents = append(nicParents, parents)
+	}
+
+	gpusWithLocalNIC := 0
+	for _, gpu := range gpus {
+		parents, err := gpu.GetParentAddresses()
+		if err != nil {
+			return nil, err
+		}
+		for _, np := range nicParents {
+			if sharePCIeSwitch(parents, np) {
+				gpusWithLocalNIC++
+				break
+			}
+		}
+	}
+
+	labels := Labels{
+		"nvidia.com/gpu.nic-affinity.gpus": strconv.Itoa(gpusWithLocalNIC),
+		"nvidia.com/gpu.nic-affinity.nics": strconv.Itoa(len(nics)),
+	}
+	return labels, nil
+}
+
+// sharePCIeSwitch checks whether two devices are connected through a PCIe switch, given the addresses of
+// their upstream bridges starting at the root port. The nearest common upstream bridge is the last one of
+// the common prefix; it is a switch port if the prefix extends beyond the root port.
+func sharePCIeSwitch(a []string, b []string) bool {
+	common := 0
+	for common < len(a) && common < len(b) && a[common] == b[common] {
+		common++
+	}
+	return common > 1
+}
@@ -0,0 +1,118 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
+	"github.com/stretchr/testify/require"
+)
+
+type pciDevicesMock []*vgpu.PCIDevice
+
+func (p pciDevicesMock) Devices() ([]*vgpu.PCIDevice, error) {
+	return p, nil
+}
+
+// newPCIDeviceMock creates a PCI device at the specified path in the sysfs device hierarchy below root.
+func newPCIDeviceMock(t *testing.T, root string, hierarchy string, vendor string, class string, rdma bool) *vgpu.PCIDevice {
+	devicePath := filepath.Join(root, "devices", hierarchy)
+	require.NoError(t, os.MkdirAll(devicePath, 0755))
+	if rdma {
+		require.NoError(t, os.MkdirAll(filepath.Join(devicePath, "infiniband", "mlx5_0"), 0755))
+	}
+
+	address := filepath.Base(hierarchy)
+	link := filepath.Join(root, "bus", address)
+	require.NoError(t, os.MkdirAll(filepath.Dir(link), 0755))
+	require.NoError(t, os.Symlink(devicePath, link))
+
+	return &vgpu.PCIDevice{
+		Path:    link,
+		Address: address,
+		Vendor:  vendor,
+		Class:   class,
+	}
+}
+
+func TestNICAffinityLabeler(t *testing.T) {
+	const (
+		gpu  = vgpu.PciDisplayControllerClass
+		nic  = vgpu.PciNetworkControllerClass
+		nv   = vgpu.PciNvidiaVendorID
+		mlnx = "0x15b3"
+	)
+
+	root := t.TempDir()
+	gpu0 := newPCIDeviceMock(t, root, "pci0000:00/0000:00:01.0/0000:01:00.0/0000:02:08.0/0000:05:00.0", nv, gpu, false)
+	gpu1 := newPCIDeviceMock(t, root, "pci0000:00/0000:00:01.0/0000:01:00.0/0000:02:09.0/0000:06:00.0", nv, gpu, false)
+	gpu2 := newPCIDeviceMock(t, root, "pci0000:00/0000:00:02.0/0000:07:00.0", nv, gpu, false)
+	nic0 := newPCIDeviceMock(t, root, "pci0000:00/0000:00:01.0/0000:01:00.0/0000:02:10.0/0000:08:00.0", mlnx, nic, true)
+	nic1 := newPCIDeviceMock(t, root, "pci0000:00/0000:00:03.0/0000:09:00.0", mlnx, nic, true)
+	nic2 := newPCIDeviceMock(t, root, "pci0000:00/0000:00:02.0/0000:0b:00.0", mlnx, nic, true)
+	ethernet := newPCIDeviceMock(t, root, "pci0000:00/0000:00:02.0/0000:0a:00.0", "0x8086", nic, false)
+
+	testCases := []struct {
+		description    string
+		devices        []*vgpu.PCIDevice
+		expectedLabels Labels
+	}{
+		{
+			description: "no gpus",
+			devices:     []*vgpu.PCIDevice{nic0},
+		},
+		{
+			description: "no rdma nics",
+			devices:     []*vgpu.PCIDevice{gpu0, gpu2, ethernet},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.nic-affinity.gpus": "0",
+				"nvidia.com/gpu.nic-affinity.nics": "0",
+			},
+		},
+		{
+			description: "gpus sharing a switch with a nic",
+			devices:     []*vgpu.PCIDevice{gpu0, gpu1, gpu2, nic0, nic1, ethernet},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.nic-affinity.gpus": "2",
+				"nvidia.com/gpu.nic-affinity.nics": "2",
+			},
+		},
+		{
+			description: "gpu sharing only a root port with a nic",
+			devices:     []*vgpu.PCIDevice{gpu2, nic2},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.nic-affinity.gpus": "0",
+				"nvidia.com/gpu.nic-affinity.nics": "1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			l, err := newNICAffinityLabeler(pciDevicesMock(tc.devices))
+			require.NoError(t, err)
+
+			labels, err := l.Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
@@ -17,16 +17,20 @@
 package lm
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
 	spec "github.com/NVIDIA/k8s-device-plugin/api/config/v1"
+	"k8s.io/klog/v2"
 )
 
 // NewNVMLLabeler creates a new NVML-based labeler using the provided NVML library and config.
-func NewNVMLLabeler(manager resource.Manager, config *spec.Config) (Labeler, error) {
+// If an inventory publisher is specified, the device inventory is collected while the manager is initialized.
+func NewNVMLLabeler(manager resource.Manager, config *spec.Config, inventory *InventoryPublisher) (Labeler, error) {
 	if err := manager.Init(); err != nil {
 		return nil, fmt.Errorf("failed to initialize NVML: %v", err)
 	}
@@ -37,11 +41,17 @@ func NewNVMLLabeler(manager resource.Manager, config *spec.Config) (Labeler, err
 		return nil, fmt.Errorf("error getting devices: %v", err)
 	}
 
+	if inventory != nil {
+		if err := inventory.Collect(devices); err != nil {
+			klog.Warningf("Error collecting device inventory: %v", err)
+		}
+	}
+
 	if len(devices) == 0 {
 		return empty{}, nil
 	}
 
-	machineTypeLabeler, err := newMachineTypeLabeler(*config.Flags.GFD.MachineTypeFile)
+	machineTypeLabeler, err := newMachineTypeLabeler(hostPath(config, *config.Flags.GFD.MachineTypeFile))
 	if err != nil {
 		return nil, fmt.Errorf("failed to construct machine type labeler: %v", err)
 	}
@@ -51,11 +61,63 @@ func NewNVMLLabeler(manager resource.Manager, config *spec.Config) (Labeler, err
 		return nil, fmt.Errorf("failed to construct version labeler: %v", err)
 	}
 
+	driverLabeler, err := newDriverLabeler(manager, config, hostPath(config, resource.DriverVersionFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct driver labeler: %v", err)
+	}
+
+	// The forward-compatibility packages are only detected if the host filesystem is mounted at the host root.
+	// Otherwise the CUDA toolkit directories of the GFD image would be searched instead of those of the host.
+	cudaCompatLabeler := Labeler(empty{})
+	if hostRoot(config) != "/" {
+		cudaCompatLabeler, err = newCudaCompatLabeler(manager, hostPath(config, cudaCompatGlob))
+		if err != nil {
+			return nil, fmt.Errorf("failed to construct CUDA forward-compatibility labeler: %v", err)
+		}
+	}
+
 	migCapabilityLabeler, err := newMigCapabilityLabeler(manager)
 	if err != nil {
 		return nil, fmt.Errorf("error creating mig capability labeler: %v", err)
 	}
 
+	migProfileLabeler, err := newMigProfileLabeler(manager)
+	if err != nil {
+		return nil, fmt.Errorf("error creating mig profile labeler: %v", err)
+	}
+
+	computeModeLabeler, err := newComputeModeLabeler(manager)
+	if err != nil {
+		return nil, fmt.Errorf("error creating compute mode labeler: %v", err)
+	}
+
+	deviceModeLabeler, err := newDeviceModeLabeler(manager)
+	if err != nil {
+		return nil, fmt.Errorf("error creating device mode labeler: %v", err)
+	}
+
+	fabricLabeler, err := newFabricLabeler(manager)
+	if err != nil {
+		return nil, fmt.Errorf("error creating fabric labeler: %v", err)
+	}
+
+	confComputeLabeler, err := newConfComputeLabeler(manager)
+	if err != nil {
+		return nil, fmt.Errorf("error creating confidential computing labeler: %v", err)
+	}
+
+	gpuDirectLabeler := newGPUDirectLabeler(hostRoot(config), config)
+
+	nicAffinityLabeler, err := newNICAffinityLabeler(vgpu.NewPCILib(hostRoot(config)))
+	if err != nil {
+		return nil, fmt.Errorf("error creating NIC affinity labeler: %v", err)
+	}
+
+	vgpuHostLabeler, err := newVGPUHostLabeler(vgpu.NewNvidiaPCILib(hostRoot(config)))
+	if err != nil {
+		return nil, fmt.Errorf("error creating vGPU host labeler: %v", err)
+	}
+
 	resourceLabeler, err := NewResourceLabeler(manager, config)
 	if err != nil {
 		return nil, fmt.Errorf("error creating resource labeler: %v", err)
@@ -64,7 +126,17 @@ func NewNVMLLabeler(manager resource.Manager, config *spec.Config) (Labeler, err
 	l := Merge(
 		machineTypeLabeler,
 		versionLabeler,
+		driverLabeler,
+		cudaCompatLabeler,
 		migCapabilityLabeler,
+		migProfileLabeler,
+		computeModeLabeler,
+		deviceModeLabeler,
+		fabricLabeler,
+		confComputeLabeler,
+		gpuDirectLabeler,
+		nicAffinityLabeler,
+		vgpuHostLabeler,
 		resourceLabeler,
 	)
 
@@ -72,36 +144,49 @@ func NewNVMLLabeler(manager resource.Manager, config *spec.Config) (Labeler, err
 }
 
 // newVersionLabeler creates a labeler that generates the CUDA and driver version labels.
+// The CUDA version supported by the driver is published as the cuda.driver-api labels. The
+// cuda.runtime labels hold the same value and are kept as a deprecated alias for existing selectors.
+// On Tegra-based systems the L4T version is also included. If the driver version cannot be
+// determined on such systems, the driver version labels are omitted.
 func newVersionLabeler(manager resource.Manager) (Labeler, error) {
-	driverVersion, err := manager.GetDriverVersion()
+	cudaMajor, cudaMinor, err := manager.GetCudaDriverVersion()
 	if err != nil {
-		return nil, fmt.Errorf("error getting driver version: %v", err)
+		return nil, fmt.Errorf("error getting cuda driver version: %v", err)
 	}
 
-	driverVersionSplit := strings.Split(driverVersion, ".")
-	if len(driverVersionSplit) > 3 || len(driverVersionSplit) < 2 {
-		return nil, fmt.Errorf("error getting driver version: Version \"%s\" does not match format \"X.Y[.Z]\"", driverVersion)
+	labels := Labels{
+		"nvidia.com/cuda.runtime.major":    fmt.Sprintf("%d", *cudaMajor),
+		"nvidia.com/cuda.runtime.minor":    fmt.Sprintf("%d", *cudaMinor),
+		"nvidia.com/cuda.driver-api.major": fmt.Sprintf("%d", *cudaMajor),
+		"nvidia.com/cuda.driver-api.minor": fmt.Sprintf("%d", *cudaMinor),
 	}
 
-	driverMajor := driverVersionSplit[0]
-	driverMinor := driverVersionSplit[1]
-	driverRev := ""
-	if len(driverVersionSplit) > 2 {
-		driverRev = driverVersionSplit[2]
+	driverVersion, err := manager.GetDriverVersion()
+	if err != nil && !errors.Is(err, resource.ErrNotSupported) {
+		return nil, fmt.Errorf("error getting driver version: %v", err)
 	}
+	if err == nil {
+		driverVersionSplit := strings.Split(driverVersion, ".")
+		if len(driverVersionSplit) > 3 || len(driverVersionSplit) < 2 {
+			return nil, fmt.Errorf("error getting driver version: Version \"%s\" does not match format \"X.Y[.Z]\"", driverVersion)
+		}
 
-	cudaMajor, cudaMinor, err := manager.GetCudaDriverVersion()
-	if err != nil {
-		return nil, fmt.Errorf("error getting cuda driver version: %v", err)
+		labels["nvidia.com/cuda.driver.major"] = driverVersionSplit[0]
+		labels["nvidia.com/cuda.driver.minor"] = driverVersionSplit[1]
+		labels["nvidia.com/cuda.driver.rev"] = ""
+		if len(driverVersionSplit) > 2 {
+			labels["nvidia.com/cuda.driver.rev"] = driverVersionSplit[2]
+		}
 	}
 
-	labels := Labels{
-		"nvidia.com/cuda.driver.major":  driverMajor,
-		"nvidia.com/cuda.driver.minor":  driverMinor,
-		"nvidia.com/cuda.driver.rev":    driverRev,
-		"nvidia.com/cuda.runtime.major": fmt.Sprintf("%d", *cudaMajor),
-		"nvidia.com/cuda.runtime.minor": fmt.Sprintf("%d", *cudaMinor),
+	l4tVersion, err := manager.GetL4TVersion()
+	if err != nil && !errors.Is(err, resource.ErrNotSupported) {
+		return nil, fmt.Errorf("error getting L4T version: %v", err)
 	}
+	if err == nil {
+		labels["nvidia.com/l4t.version"] = l4tVersion
+	}
+
 	return labels, nil
 }
 
@@ -75,3 +75,68 @@ func TestMigCapabilityLabeler(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionLabeler(t *testing.T) {
+	testCases := []struct {
+		description    string
+		manager        resource.Manager
+		expectedLabels Labels
+	}{
+		{
+			description: "nvml system has no l4t version",
+			manager:     rt.NewManagerMockWithDevices(rt.NewFullGPU()),
+			expectedLabels: Labels{
+				"nvidia.com/cuda.driver.major":     "400",
+				"nvidia.com/cuda.driver.minor":     "300",
+				"nvidia.com/cuda.driver.rev":       "",
+				"nvidia.com/cuda.runtime.major":    "8",
+				"nvidia.com/cuda.runtime.minor":    "0",
+				"nvidia.com/cuda.driver-api.major": "8",
+				"nvidia.com/cuda.driver-api.minor": "0",
+			},
+		},
+		{
+			description: "tegra system includes l4t version",
+			manager:     rt.NewManagerMockWithDevices(rt.NewFullGPU()).WithL4TVersion("35.4.1"),
+			expectedLabels: Labels{
+				"nvidia.com/cuda.driver.major":     "400",
+				"nvidia.com/cuda.driver.minor":     "300",
+				"nvidia.com/cuda.driver.rev":       "",
+				"nvidia.com/cuda.runtime.major":    "8",
+				"nvidia.com/cuda.runtime.minor":    "0",
+				"nvidia.com/cuda.driver-api.major": "8",
+				"nvidia.com/cuda.driver-api.minor": "0",
+				"nvidia.com/l4t.version":           "35.4.1",
+			},
+		},
+		{
+			description: "tegra system without driver version only includes l4t version",
+			manager: func() resource.Manager {
+				m := rt.NewManagerMockWithDevices(rt.NewFullGPU()).WithL4TVersion("35.4.1")
+				m.GetDriverVersionFunc = func() (string, error) {
+					return "", resource.ErrNotSupported
+				}
+				return m
+			}(),
+			expectedLabels: Labels{
+				"nvidia.com/cuda.runtime.major":    "8",
+				"nvidia.com/cuda.runtime.minor":    "0",
+				"nvidia.com/cuda.driver-api.major": "8",
+				"nvidia.com/cuda.driver-api.minor": "0",
+				"nvidia.com/l4t.version":           "35.4.1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			l, err := newVersionLabeler(tc.manager)
+			require.NoError(t, err)
+
+			labels, err := l.Labels()
+			require.NoError(t, err)
+
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
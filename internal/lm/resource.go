@@ -17,6 +17,7 @@
 package lm
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -58,6 +59,27 @@ func NewGPUResourceLabeler(config *spec.Config, device resource.Device, count in
 		return nil, fmt.Errorf("failed to create architecture labels: %v", err)
 	}
 
+	migEnabled, err := device.IsMigEnabled()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if MIG is enabled: %v", err)
+	}
+
+	// The attributes of a GPU with MIG enabled are reported for its MIG devices instead
+	// and its power limits and clocks are not those of the MIG devices.
+	performanceLabels := make(Labels)
+	attributeLabels := make(Labels)
+	if !migEnabled {
+		performanceLabels, err = newPerformanceLabels(resourceLabeler, device)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create performance labels: %v", err)
+		}
+
+		attributeLabels, err = newFullGPUAttributeLabels(resourceLabeler, device)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create attribute labels: %v", err)
+		}
+	}
+
 	memoryLabeler := (Labeler)(&empty{})
 	if totalMemoryMB != 0 {
 		memoryLabeler = resourceLabeler.single("memory", totalMemoryMB)
@@ -67,6 +89,8 @@ func NewGPUResourceLabeler(config *spec.Config, device resource.Device, count in
 		resourceLabeler.baseLabeler(count, model),
 		memoryLabeler,
 		architectureLabels,
+		performanceLabels,
+		attributeLabels,
 	)
 
 	return labelers, nil
@@ -236,6 +260,23 @@ func newMigAttributeLabels(rl resourceLabeler, device resource.Device) (Labels,
 	return labels, nil
 }
 
+// newFullGPUAttributeLabels creates labels for the attributes of a full GPU such as its multiprocessor
+// and engine counts.
+// If the attributes are not supported by the device, no labels are generated.
+func newFullGPUAttributeLabels(rl resourceLabeler, device resource.Device) (Labels, error) {
+	attributes, err := device.GetAttributes()
+	if errors.Is(err, resource.ErrNotSupported) {
+		return make(Labels), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to get attributes of device: %v", err)
+	}
+
+	labels := rl.labels(attributes)
+
+	return labels, nil
+}
+
 func newArchitectureLabels(rl resourceLabeler, device resource.Device) (Labels, error) {
 	computeMajor, computeMinor, err := device.GetCudaComputeCapability()
 	if err != nil {
@@ -246,7 +287,7 @@ func newArchitectureLabels(rl resourceLabeler, device resource.Device) (Labels,
 		return make(Labels), nil
 	}
 
-	family := getArchFamily(computeMajor, computeMinor)
+	family := getArchFamily(rl.config, computeMajor, computeMinor)
 
 	labels := rl.labels(map[string]interface{}{
 		"family":        family,
@@ -257,28 +298,28 @@ func newArchitectureLabels(rl resourceLabeler, device resource.Device) (Labels,
 	return labels, nil
 }
 
-// TODO: This should a function in go-nvlib
-func getArchFamily(computeMajor, computeMinor int) string {
-	switch computeMajor {
-	case 1:
-		return "tesla"
-	case 2:
-		return "fermi"
-	case 3:
-		return "kepler"
-	case 5:
-		return "maxwell"
-	case 6:
-		return "pascal"
-	case 7:
-		if computeMinor < 5 {
-			return "volta"
-		}
-		return "turing"
-	case 8:
-		return "ampere"
-	case 9:
-		return "hopper"
+// newPerformanceLabels creates labels for the power limits and maximum clocks of the device.
+// Labels for values that are not supported by the device are omitted.
+func newPerformanceLabels(rl resourceLabeler, device resource.Device) (Labels, error) {
+	labels := make(Labels)
+
+	enforcedPowerLimit, defaultPowerLimit, err := device.GetPowerLimitsW()
+	if err != nil && !errors.Is(err, resource.ErrNotSupported) {
+		return nil, fmt.Errorf("failed to get power limits: %v", err)
+	}
+	if err == nil {
+		rl.updateLabel(labels, "power.limit.enforced", enforcedPowerLimit)
+		rl.updateLabel(labels, "power.limit.default", defaultPowerLimit)
+	}
+
+	smClock, memoryClock, err := device.GetMaxClocksMHz()
+	if err != nil && !errors.Is(err, resource.ErrNotSupported) {
+		return nil, fmt.Errorf("failed to get maximum clocks: %v", err)
 	}
-	return "undefined"
+	if err == nil {
+		rl.updateLabel(labels, "clocks.sm.max", smClock)
+		rl.updateLabel(labels, "clocks.memory.max", memoryClock)
+	}
+
+	return labels, nil
 }
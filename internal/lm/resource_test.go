@@ -40,13 +40,17 @@ func TestGPUResourceLabeler(t *testing.T) {
 			description: "no sharing",
 			count:       1,
 			expectedLabels: Labels{
-				"nvidia.com/gpu.count":         "1",
-				"nvidia.com/gpu.replicas":      "1",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
+				"nvidia.com/gpu.count":                "1",
+				"nvidia.com/gpu.replicas":             "1",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
 			},
 		},
 		{
@@ -61,13 +65,17 @@ func TestGPUResourceLabeler(t *testing.T) {
 				},
 			},
 			expectedLabels: Labels{
-				"nvidia.com/gpu.count":         "1",
-				"nvidia.com/gpu.replicas":      "1",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
+				"nvidia.com/gpu.count":                "1",
+				"nvidia.com/gpu.replicas":             "1",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
 			},
 		},
 		{
@@ -82,13 +90,17 @@ func TestGPUResourceLabeler(t *testing.T) {
 				},
 			},
 			expectedLabels: Labels{
-				"nvidia.com/gpu.count":         "1",
-				"nvidia.com/gpu.replicas":      "2",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL-SHARED",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
+				"nvidia.com/gpu.count":                "1",
+				"nvidia.com/gpu.replicas":             "2",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL-SHARED",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
 			},
 		},
 		{
@@ -104,13 +116,17 @@ func TestGPUResourceLabeler(t *testing.T) {
 				},
 			},
 			expectedLabels: Labels{
-				"nvidia.com/gpu.count":         "1",
-				"nvidia.com/gpu.replicas":      "2",
-				"nvidia.com/gpu.memory":        "300",
-				"nvidia.com/gpu.product":       "MOCKMODEL",
-				"nvidia.com/gpu.family":        "ampere",
-				"nvidia.com/gpu.compute.major": "8",
-				"nvidia.com/gpu.compute.minor": "0",
+				"nvidia.com/gpu.count":                "1",
+				"nvidia.com/gpu.replicas":             "2",
+				"nvidia.com/gpu.memory":               "300",
+				"nvidia.com/gpu.product":              "MOCKMODEL",
+				"nvidia.com/gpu.family":               "ampere",
+				"nvidia.com/gpu.compute.major":        "8",
+				"nvidia.com/gpu.compute.minor":        "0",
+				"nvidia.com/gpu.power.limit.enforced": "400",
+				"nvidia.com/gpu.power.limit.default":  "400",
+				"nvidia.com/gpu.clocks.sm.max":        "1410",
+				"nvidia.com/gpu.clocks.memory.max":    "1215",
 			},
 		},
 	}
@@ -134,6 +150,92 @@ func TestGPUResourceLabeler(t *testing.T) {
 
 }
 
+func TestGPUResourceLabelerAttributes(t *testing.T) {
+	device := rt.NewDeviceMock(false).WithAttributes(map[string]interface{}{
+		"engines.copy":                  7,
+		"engines.decoder":               5,
+		"engines.encoder":               0,
+		"engines.jpeg":                  1,
+		"engines.ofa":                   1,
+		"engines.encoder.capacity.h264": 100,
+		"multiprocessors":               98,
+		"memory.bus-width":              5120,
+	})
+
+	l, err := NewGPUResourceLabelerWithoutSharing(device, 1)
+	require.NoError(t, err)
+
+	labels, err := l.Labels()
+	require.NoError(t, err)
+
+	expectedLabels := Labels{
+		"nvidia.com/gpu.engines.copy":                  "7",
+		"nvidia.com/gpu.engines.decoder":               "5",
+		"nvidia.com/gpu.engines.encoder":               "0",
+		"nvidia.com/gpu.engines.jpeg":                  "1",
+		"nvidia.com/gpu.engines.ofa":                   "1",
+		"nvidia.com/gpu.engines.encoder.capacity.h264": "100",
+		"nvidia.com/gpu.multiprocessors":               "98",
+		"nvidia.com/gpu.memory.bus-width":              "5120",
+		"nvidia.com/gpu.memory":                        "300",
+	}
+	for k, v := range expectedLabels {
+		require.Equal(t, v, labels[k], k)
+	}
+	require.NotContains(t, labels, "nvidia.com/gpu.engines.encoder.capacity.hevc")
+}
+
+func TestGPUResourceLabelerMigEnabledAttributes(t *testing.T) {
+	device := rt.NewDeviceMock(true).WithAttributes(map[string]interface{}{
+		"engines.copy":     7,
+		"multiprocessors":  98,
+		"memory.bus-width": 5120,
+	})
+
+	l, err := NewGPUResourceLabelerWithoutSharing(device, 1)
+	require.NoError(t, err)
+
+	labels, err := l.Labels()
+	require.NoError(t, err)
+
+	require.NotContains(t, labels, "nvidia.com/gpu.engines.copy")
+	require.NotContains(t, labels, "nvidia.com/gpu.multiprocessors")
+	require.NotContains(t, labels, "nvidia.com/gpu.memory.bus-width")
+}
+
+func TestGPUResourceLabelerCudaAttributes(t *testing.T) {
+	device := rt.NewDeviceMock(false).WithAttributes(map[string]interface{}{
+		"multiprocessors":    16,
+		"memory.bus-width":   256,
+		"memory.type":        "system",
+		"cache.l2":           4096,
+		"integrated":         true,
+		"unified-addressing": true,
+		"managed-memory":     true,
+		"concurrent-kernels": true,
+	})
+
+	l, err := NewGPUResourceLabelerWithoutSharing(device, 1)
+	require.NoError(t, err)
+
+	labels, err := l.Labels()
+	require.NoError(t, err)
+
+	expectedLabels := Labels{
+		"nvidia.com/gpu.multiprocessors":    "16",
+		"nvidia.com/gpu.memory.bus-width":   "256",
+		"nvidia.com/gpu.memory.type":        "system",
+		"nvidia.com/gpu.cache.l2":           "4096",
+		"nvidia.com/gpu.integrated":         "true",
+		"nvidia.com/gpu.unified-addressing": "true",
+		"nvidia.com/gpu.managed-memory":     "true",
+		"nvidia.com/gpu.concurrent-kernels": "true",
+	}
+	for k, v := range expectedLabels {
+		require.Equal(t, v, labels[k], k)
+	}
+}
+
 func TestMigResourceLabeler(t *testing.T) {
 
 	device := rt.NewMigDevice(1, 2, 300)
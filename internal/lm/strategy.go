@@ -16,13 +16,20 @@
 
 package lm
 
-// migStrategyLabeler creates a labler for setting the mig strategy label
+const (
+	migStrategyStatusValid   = "valid"
+	migStrategyStatusInvalid = "invalid"
+)
+
+// migStrategyLabeler creates a labler for setting the mig strategy label.
+// The strategy is assumed to be valid; labelers for invalid configurations override the status.
 func migStrategyLabeler(strategy string) Labeler {
 	if strategy == MigStrategyNone {
 		return empty{}
 	}
 
 	return Labels{
-		"nvidia.com/mig.strategy": strategy,
+		"nvidia.com/mig.strategy":        strategy,
+		"nvidia.com/mig.strategy.status": migStrategyStatusValid,
 	}
 }
@@ -0,0 +1,157 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+)
+
+const (
+	vgpuHostTypeLabelPrefix = "nvidia.com/"
+	vgpuHostTypeLabelName   = "vgpu.host.type."
+	// labelNameMaxLength is the maximum length of the name part of a label key
+	labelNameMaxLength = 63
+)
+
+// invalidLabelNameChars matches the characters that are not allowed in the name part of a label key
+var invalidLabelNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// vgpuHostTypeCount tracks the instances of a vGPU type that can still be created on a physical GPU
+type vgpuHostTypeCount struct {
+	available    int
+	maxInstances int
+}
+
+// newVGPUHostLabeler creates a labeler for the vGPU types that can be created on a hypervisor running the
+// vGPU manager. The supported types are read from the mdev sysfs entries of the NVIDIA GPUs (or of their
+// SR-IOV virtual functions) and a label is generated per type with the number of instances that can still be
+// created on the node. The SR-IOV virtual function counts of the GPUs are also included.
+// On SR-IOV GPUs each virtual function reports whether it can host one more instance of a type, so the free
+// VFs are counted per type and physical function. Since all instances draw from the same physical GPU, this
+// count is bounded by the maximum number of instances of the type per GPU if the vGPU manager reports it.
+// Types whose name cannot be turned into a valid label key are skipped. If the PCI devices cannot be read,
+// no labels are generated.
+func newVGPUHostLabeler(pci vgpu.NvidiaPCI) (Labeler, error) {
+	devices, err := pci.Devices()
+	if err != nil {
+		klog.Warningf("Unable to get NVIDIA PCI devices for vGPU host labels: %v", err)
+		return empty{}, nil
+	}
+
+	sriov := vgpu.SriovInfo{}
+	sriovCapable := false
+	availablePerPF := make(map[string]map[string]*vgpuHostTypeCount)
+	for _, d := range devices {
+		if d.Class != vgpu.PciDisplayControllerClass {
+			continue
+		}
+
+		types, err := d.GetMdevTypes()
+		if err != nil {
+			return nil, err
+		}
+		if len(types) > 0 {
+			pf, err := d.GetPhysicalFunctionAddress()
+			if err != nil {
+				return nil, err
+			}
+			if availablePerPF[pf] == nil {
+				availablePerPF[pf] = make(map[string]*vgpuHostTypeCount)
+			}
+			for _, t := range types {
+				count := availablePerPF[pf][t.Name]
+				if count == nil {
+					count = &vgpuHostTypeCount{maxInstances: t.MaxInstances}
+					availablePerPF[pf][t.Name] = count
+				}
+				count.available += t.AvailableInstances
+			}
+		}
+
+		if d.IsVirtualFunction() {
+			continue
+		}
+		info, err := d.GetSriovInfo()
+		if err != nil {
+			return nil, err
+		}
+		if info == nil || info.TotalVFs == 0 {
+			continue
+		}
+		sriovCapable = true
+		sriov.TotalVFs += info.TotalVFs
+		sriov.NumVFs += info.NumVFs
+	}
+
+	available := make(map[string]int)
+	for _, types := range availablePerPF {
+		for name, count := range types {
+			if count.maxInstances > 0 && count.available > count.maxInstances {
+				count.available = count.maxInstances
+			}
+			available[name] += count.available
+		}
+	}
+
+	labels := make(Labels)
+	if len(available) > 0 {
+		labels["nvidia.com/vgpu.host.present"] = "true"
+	}
+	for name, count := range available {
+		key, err := vgpuHostTypeLabel(name)
+		if err != nil {
+			klog.Warningf("Skipping vGPU type %q: %v", name, err)
+			continue
+		}
+		labels[key] = strconv.Itoa(count)
+	}
+	if sriovCapable {
+		labels["nvidia.com/vgpu.host.sriov.total-vfs"] = strconv.Itoa(sriov.TotalVFs)
+		labels["nvidia.com/vgpu.host.sriov.num-vfs"] = strconv.Itoa(sriov.NumVFs)
+	}
+
+	return labels, nil
+}
+
+// vgpuHostTypeLabel returns the key of the label for the specified vGPU type.
+// An error is returned if no valid label key can be constructed from the type name.
+func vgpuHostTypeLabel(typeName string) (string, error) {
+	key := vgpuHostTypeLabelPrefix + vgpuHostTypeLabelName + sanitizeLabelName(typeName, labelNameMaxLength-len(vgpuHostTypeLabelName))
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return "", fmt.Errorf("invalid label key %q: %v", key, strings.Join(errs, "; "))
+	}
+	return key, nil
+}
+
+// sanitizeLabelName replaces the characters that are not valid in a label name with a dash and truncates the
+// result to the specified length. Label names must start and end with an alphanumeric character, so any other
+// leading or trailing characters are removed.
+func sanitizeLabelName(name string, maxLength int) string {
+	name = invalidLabelNameChars.ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-._")
+	if len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-._")
+	}
+	return name
+}
@@ -0,0 +1,286 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
+	"github.com/stretchr/testify/require"
+)
+
+// vgpuHostDevice describes the sysfs entries of an NVIDIA GPU on a vGPU host
+type vgpuHostDevice struct {
+	address   string
+	physfn    string
+	sriov     *vgpu.SriovInfo
+	mdevTypes []vgpu.MdevType
+}
+
+func (d vgpuHostDevice) create(t *testing.T, root string) *vgpu.PCIDevice {
+	devicePath := filepath.Join(root, d.address)
+	require.NoError(t, os.MkdirAll(devicePath, 0755))
+	if d.physfn != "" {
+		require.NoError(t, os.Symlink(filepath.Join("..", d.physfn), filepath.Join(devicePath, "physfn")))
+	}
+	if d.sriov != nil {
+		require.NoError(t, os.WriteFile(filepath.Join(devicePath, vgpu.SriovTotalVFsFile), []byte(strconv.Itoa(d.sriov.TotalVFs)+"\n"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(devicePath, vgpu.SriovNumVFsFile), []byte(strconv.Itoa(d.sriov.NumVFs)+"\n"), 0644))
+	}
+	for _, mdevType := range d.mdevTypes {
+		typePath := filepath.Join(devicePath, vgpu.MdevSupportedTypesDir, mdevType.ID)
+		require.NoError(t, os.MkdirAll(typePath, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(typePath, "name"), []byte(mdevType.Name+"\n"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(typePath, "available_instances"), []byte(strconv.Itoa(mdevType.AvailableInstances)+"\n"), 0644))
+		if mdevType.MaxInstances > 0 {
+			description := fmt.Sprintf("num_heads=1, frl_config=60, framebuffer=4096M, max_resolution=4096x2160, max_instance=%d\n", mdevType.MaxInstances)
+			require.NoError(t, os.WriteFile(filepath.Join(typePath, "description"), []byte(description), 0644))
+		}
+	}
+
+	return &vgpu.PCIDevice{
+		Path:    devicePath,
+		Address: d.address,
+		Vendor:  vgpu.PciNvidiaVendorID,
+		Class:   vgpu.PciDisplayControllerClass,
+	}
+}
+
+func TestVGPUHostLabeler(t *testing.T) {
+	testCases := []struct {
+		description    string
+		devices        []vgpuHostDevice
+		expectedLabels Labels
+	}{
+		{
+			description:    "no devices",
+			devices:        []vgpuHostDevice{},
+			expectedLabels: Labels{},
+		},
+		{
+			description: "guest or bare-metal GPU",
+			devices: []vgpuHostDevice{
+				{address: "0000:3b:00.0"},
+			},
+			expectedLabels: Labels{},
+		},
+		{
+			description: "time-sliced vGPU host",
+			devices: []vgpuHostDevice{
+				{
+					address: "0000:3b:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-222", Name: "GRID T4-1B", AvailableInstances: 16},
+						{ID: "nvidia-230", Name: "GRID T4-16Q", AvailableInstances: 1},
+					},
+				},
+				{
+					address: "0000:af:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-222", Name: "GRID T4-1B", AvailableInstances: 8},
+						{ID: "nvidia-230", Name: "GRID T4-16Q", AvailableInstances: 0},
+					},
+				},
+			},
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.host.present":          "true",
+				"nvidia.com/vgpu.host.type.GRID-T4-1B":  "24",
+				"nvidia.com/vgpu.host.type.GRID-T4-16Q": "1",
+			},
+		},
+		{
+			description: "SR-IOV vGPU host",
+			devices: []vgpuHostDevice{
+				{
+					address: "0000:3b:00.0",
+					sriov:   &vgpu.SriovInfo{TotalVFs: 16, NumVFs: 2},
+				},
+				{
+					address: "0000:3b:00.4",
+					physfn:  "0000:3b:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-472", Name: "NVIDIA A100-4C", AvailableInstances: 1, MaxInstances: 10},
+						{ID: "nvidia-473", Name: "NVIDIA A100-5C", AvailableInstances: 0, MaxInstances: 8},
+					},
+				},
+				{
+					address: "0000:3b:00.5",
+					physfn:  "0000:3b:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-472", Name: "NVIDIA A100-4C", AvailableInstances: 1, MaxInstances: 10},
+						{ID: "nvidia-473", Name: "NVIDIA A100-5C", AvailableInstances: 0, MaxInstances: 8},
+					},
+				},
+			},
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.host.present":             "true",
+				"nvidia.com/vgpu.host.type.NVIDIA-A100-4C": "2",
+				"nvidia.com/vgpu.host.type.NVIDIA-A100-5C": "0",
+				"nvidia.com/vgpu.host.sriov.total-vfs":     "16",
+				"nvidia.com/vgpu.host.sriov.num-vfs":       "2",
+			},
+		},
+		{
+			description: "SR-IOV vGPU host with multiple GPUs",
+			devices: []vgpuHostDevice{
+				{
+					address: "0000:3b:00.0",
+					sriov:   &vgpu.SriovInfo{TotalVFs: 16, NumVFs: 1},
+				},
+				{
+					address: "0000:3b:00.4",
+					physfn:  "0000:3b:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-472", Name: "NVIDIA A100-4C", AvailableInstances: 1},
+					},
+				},
+				{
+					address: "0000:af:00.0",
+					sriov:   &vgpu.SriovInfo{TotalVFs: 16, NumVFs: 2},
+				},
+				{
+					address: "0000:af:00.4",
+					physfn:  "0000:af:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-472", Name: "NVIDIA A100-4C", AvailableInstances: 0},
+					},
+				},
+				{
+					address: "0000:af:00.5",
+					physfn:  "0000:af:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-472", Name: "NVIDIA A100-4C", AvailableInstances: 1},
+					},
+				},
+			},
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.host.present":             "true",
+				"nvidia.com/vgpu.host.type.NVIDIA-A100-4C": "2",
+				"nvidia.com/vgpu.host.sriov.total-vfs":     "32",
+				"nvidia.com/vgpu.host.sriov.num-vfs":       "3",
+			},
+		},
+		{
+			description: "free VFs are bounded by the maximum instances of a type",
+			devices: []vgpuHostDevice{
+				{
+					address: "0000:3b:00.0",
+					sriov:   &vgpu.SriovInfo{TotalVFs: 16, NumVFs: 3},
+				},
+				{
+					address: "0000:3b:00.4",
+					physfn:  "0000:3b:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-477", Name: "NVIDIA A100-20C", AvailableInstances: 1, MaxInstances: 2},
+					},
+				},
+				{
+					address: "0000:3b:00.5",
+					physfn:  "0000:3b:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-477", Name: "NVIDIA A100-20C", AvailableInstances: 1, MaxInstances: 2},
+					},
+				},
+				{
+					address: "0000:3b:00.6",
+					physfn:  "0000:3b:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-477", Name: "NVIDIA A100-20C", AvailableInstances: 1, MaxInstances: 2},
+					},
+				},
+			},
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.host.present":              "true",
+				"nvidia.com/vgpu.host.type.NVIDIA-A100-20C": "2",
+				"nvidia.com/vgpu.host.sriov.total-vfs":      "16",
+				"nvidia.com/vgpu.host.sriov.num-vfs":        "3",
+			},
+		},
+		{
+			description: "SR-IOV capable GPU without VFs",
+			devices: []vgpuHostDevice{
+				{
+					address: "0000:3b:00.0",
+					sriov:   &vgpu.SriovInfo{TotalVFs: 16},
+				},
+			},
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.host.sriov.total-vfs": "16",
+				"nvidia.com/vgpu.host.sriov.num-vfs":   "0",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			root := t.TempDir()
+
+			var devices pciDevicesMock
+			for _, d := range tc.devices {
+				devices = append(devices, d.create(t, root))
+			}
+
+			l, err := newVGPUHostLabeler(devices)
+			require.NoError(t, err)
+
+			labels, err := l.Labels()
+			require.NoError(t, err)
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
+
+func TestVGPUHostTypeLabel(t *testing.T) {
+	testCases := []struct {
+		typeName      string
+		expectedKey   string
+		expectedError bool
+	}{
+		{
+			typeName:    "NVIDIA A100-4C",
+			expectedKey: "nvidia.com/vgpu.host.type.NVIDIA-A100-4C",
+		},
+		{
+			typeName:    "GRID T4-1B (deprecated)",
+			expectedKey: "nvidia.com/vgpu.host.type.GRID-T4-1B-deprecated",
+		},
+		{
+			typeName:    "NVIDIA RTX Virtual Workstation with a very long type name-48Q",
+			expectedKey: "nvidia.com/vgpu.host.type.NVIDIA-RTX-Virtual-Workstation-with-a-very-long",
+		},
+		{
+			typeName:      "()",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.typeName, func(t *testing.T) {
+			key, err := vgpuHostTypeLabel(tc.typeName)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedKey, key)
+		})
+	}
+}
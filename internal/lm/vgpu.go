@@ -18,38 +18,133 @@ package lm
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
 	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
 )
 
+const (
+	// vgpuHostDriverMismatchReason is the reason of the event created when vGPUs are backed by different host drivers
+	vgpuHostDriverMismatchReason = "VGPUHostDriverMismatch"
+)
+
+// Methods used to detect vGPUs when the PCI configuration space cannot be read
+const (
+	vgpuDetectionNVML    = "nvml"
+	vgpuDetectionDMI     = "dmi"
+	vgpuDetectionUnknown = "unknown"
+)
+
 // vgpuLabeler manages VGPUs labels for the node
 type vgpuLabeler struct {
-	lib vgpu.Interface
+	virtualization *virtualization
+	events         EventRecorder
 }
 
-// NewVGPULabeler creates a new VGP label manager using the provided vgpu library
-// and config.
-func NewVGPULabeler(vgpu vgpu.Interface) Labeler {
-	return vgpuLabeler{lib: vgpu}
+// newVGPULabeler creates a new VGP label manager using the detected virtualization of the node.
+// Warnings about the vGPU configuration are recorded as node events.
+func newVGPULabeler(v *virtualization, events EventRecorder) Labeler {
+	return vgpuLabeler{virtualization: v, events: events}
 }
 
-// Labels generates the VGPU labels for the node
+// Labels generates the VGPU labels for the node.
+// If the vGPUs are backed by hosts running different driver versions, the minimum host driver
+// version (and its branch) is reported, the host driver is labeled as mixed, and a warning event is
+// recorded for the node.
 func (manager vgpuLabeler) Labels() (Labels, error) {
-	devices, err := manager.lib.Devices()
-	if err != nil {
-		return nil, fmt.Errorf("unable to get vGPU devices: %v", err)
+	if manager.virtualization.incompleteConfigSpace {
+		return manager.fallbackLabels(), nil
 	}
+	devices := manager.virtualization.vgpus
 	labels := make(Labels)
-	if len(devices) > 0 {
-		labels["nvidia.com/vgpu.present"] = "true"
+	if len(devices) == 0 {
+		return labels, nil
 	}
+	labels["nvidia.com/vgpu.present"] = "true"
+
+	var minimum *vgpu.Info
+	versions := make(map[string]bool)
 	for _, device := range devices {
 		info, err := device.GetInfo()
 		if err != nil {
 			return nil, fmt.Errorf("error getting vGPU device info: %v", err)
 		}
-		labels["nvidia.com/vgpu.host-driver-version"] = info.HostDriverVersion
-		labels["nvidia.com/vgpu.host-driver-branch"] = info.HostDriverBranch
+		versions[info.HostDriverVersion] = true
+		if minimum == nil || compareDriverVersions(info.HostDriverVersion, minimum.HostDriverVersion) < 0 {
+			minimum = info
+		}
 	}
+
+	labels["nvidia.com/vgpu.host-driver-version"] = minimum.HostDriverVersion
+	labels["nvidia.com/vgpu.host-driver-branch"] = minimum.HostDriverBranch
+	labels["nvidia.com/vgpu.host-driver-mixed"] = strconv.FormatBool(len(versions) > 1)
+
+	if len(versions) > 1 {
+		var found []string
+		for version := range versions {
+			found = append(found, version)
+		}
+		sort.Slice(found, func(i, j int) bool { return compareDriverVersions(found[i], found[j]) < 0 })
+		manager.events.Warning(vgpuHostDriverMismatchReason, fmt.Sprintf("vGPUs are backed by different host driver versions (%s); using minimum version %s", strings.Join(found, ", "), minimum.HostDriverVersion))
+	}
+
 	return labels, nil
 }
+
+// fallbackLabels generates the VGPU labels when the PCI configuration space cannot be read (i.e. GFD is not
+// privileged). The virtualization mode reported by NVML is used if available. Otherwise the DMI data is used to
+// rule out vGPUs on bare-metal systems. The host driver labels are not available in this case, and the method
+// used for detection is published in the vgpu.detection label.
+func (manager vgpuLabeler) fallbackLabels() Labels {
+	modes := manager.virtualization.nvmlModes
+	if len(modes) > 0 {
+		labels := Labels{
+			"nvidia.com/vgpu.detection": vgpuDetectionNVML,
+		}
+		if modes[resource.VirtualizationModeVGPU] {
+			labels["nvidia.com/vgpu.present"] = "true"
+		}
+		return labels
+	}
+
+	if virtual := manager.virtualization.virtualMachine; virtual != nil && !*virtual {
+		return Labels{
+			"nvidia.com/vgpu.detection": vgpuDetectionDMI,
+		}
+	}
+
+	return Labels{
+		"nvidia.com/vgpu.detection": vgpuDetectionUnknown,
+	}
+}
+
+// compareDriverVersions compares two dot-separated driver versions component by component.
+// Numeric components are compared as numbers, all others as strings. A version that is a prefix
+// of the other is considered smaller.
+func compareDriverVersions(a string, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil && an != bn:
+			if an < bn {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && as[i] != bs[i]:
+			return strings.Compare(as[i], bs[i])
+		}
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,212 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
+	"github.com/stretchr/testify/require"
+)
+
+// eventRecorderMock records the reasons of the warnings it receives
+type eventRecorderMock struct {
+	reasons []string
+}
+
+func (r *eventRecorderMock) Warning(reason string, message string) {
+	r.reasons = append(r.reasons, reason)
+}
+
+// newVGPUDeviceMock creates a vGPU PCI device backed by a host running the specified driver
+func newVGPUDeviceMock(address string, version string, branch string) *vgpu.PCIDevice {
+	const capabilityOffset = 0x40
+
+	record := make([]byte, 2+vgpu.HostDriverVersionLength+vgpu.HostDriverBranchLength)
+	// The host driver record has the type 0
+	record[0] = 0
+	record[1] = byte(len(record))
+	copy(record[2:], version)
+	copy(record[2+vgpu.HostDriverVersionLength:], branch)
+
+	capability := append([]byte{vgpu.PciCapabilityVendorSpecificID, 0x00, 0x00, 'V', 'F'}, record...)
+	capability[vgpu.PciCapabilityLength] = byte(len(capability))
+
+	config := make([]byte, 256)
+	config[vgpu.PciStatusByte] = vgpu.PciStatusCapabilityList
+	config[vgpu.PciCapabilityList] = capabilityOffset
+	copy(config[capabilityOffset:], capability)
+
+	return &vgpu.PCIDevice{
+		Address: address,
+		Vendor:  vgpu.PciNvidiaVendorID,
+		Class:   vgpu.PciDisplayControllerClass,
+		Config:  config,
+	}
+}
+
+func TestVGPULabeler(t *testing.T) {
+	testCases := []struct {
+		description    string
+		devices        pciDevicesMock
+		expectedLabels Labels
+		expectedEvents []string
+	}{
+		{
+			description:    "no vGPUs",
+			expectedLabels: Labels{},
+		},
+		{
+			description: "single host driver",
+			devices: pciDevicesMock{
+				newVGPUDeviceMock("0000:00:05.0", "535.104.06", "r535_00"),
+				newVGPUDeviceMock("0000:00:06.0", "535.104.06", "r535_00"),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.present":             "true",
+				"nvidia.com/vgpu.host-driver-version": "535.104.06",
+				"nvidia.com/vgpu.host-driver-branch":  "r535_00",
+				"nvidia.com/vgpu.host-driver-mixed":   "false",
+			},
+		},
+		{
+			description: "mixed host drivers report the minimum version",
+			devices: pciDevicesMock{
+				newVGPUDeviceMock("0000:00:05.0", "535.104.06", "r535_00"),
+				newVGPUDeviceMock("0000:00:06.0", "525.60.12", "r525_00"),
+				newVGPUDeviceMock("0000:00:07.0", "535.54.06", "r535_00"),
+			},
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.present":             "true",
+				"nvidia.com/vgpu.host-driver-version": "525.60.12",
+				"nvidia.com/vgpu.host-driver-branch":  "r525_00",
+				"nvidia.com/vgpu.host-driver-mixed":   "true",
+			},
+			expectedEvents: []string{vgpuHostDriverMismatchReason},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			v, err := detectVirtualization(rt.NewManagerMockWithDevices(rt.NewFullGPU()), vgpu.NewVGPULib(tc.devices), pciDevicesMock{}, t.TempDir())
+			require.NoError(t, err)
+
+			events := &eventRecorderMock{}
+			labels, err := newVGPULabeler(v, events).Labels()
+			require.NoError(t, err)
+			require.EqualValues(t, tc.expectedLabels, labels)
+			require.EqualValues(t, tc.expectedEvents, events.reasons)
+		})
+	}
+}
+
+func TestVGPULabelerUnprivileged(t *testing.T) {
+	// Without privileges only the PCI configuration header can be read
+	unprivileged := pciDevicesMock{
+		{
+			Address: "0000:00:05.0",
+			Vendor:  vgpu.PciNvidiaVendorID,
+			Class:   vgpu.PciDisplayControllerClass,
+			Config:  make([]byte, 64),
+		},
+	}
+
+	testCases := []struct {
+		description    string
+		manager        resource.Manager
+		dmi            []string
+		expectedLabels Labels
+	}{
+		{
+			description: "NVML reports vGPU",
+			manager:     rt.NewManagerMockWithDevices(rt.NewDeviceMock(false).WithVirtualizationMode(resource.VirtualizationModeVGPU)),
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.detection": "nvml",
+				"nvidia.com/vgpu.present":   "true",
+			},
+		},
+		{
+			description: "NVML reports passthrough",
+			manager:     rt.NewManagerMockWithDevices(rt.NewDeviceMock(false).WithVirtualizationMode(resource.VirtualizationModePassthrough)),
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.detection": "nvml",
+			},
+		},
+		{
+			description: "NVML unavailable on bare metal",
+			manager:     rt.NewManagerMockWithDevices().WithErrorOnInit(fmt.Errorf("init failed")),
+			dmi:         []string{"Supermicro", "AS -4124GO-NART"},
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.detection": "dmi",
+			},
+		},
+		{
+			description: "NVML unavailable in virtual machine",
+			manager:     rt.NewManagerMockWithDevices().WithErrorOnInit(fmt.Errorf("init failed")),
+			dmi:         []string{"QEMU", "Standard PC (Q35 + ICH9, 2009)"},
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.detection": "unknown",
+			},
+		},
+		{
+			description: "NVML and DMI unavailable",
+			manager:     rt.NewManagerMockWithDevices(rt.NewDeviceMock(false).WithVirtualizationMode(resource.VirtualizationModeUnknown)),
+			expectedLabels: Labels{
+				"nvidia.com/vgpu.detection": "unknown",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			root := t.TempDir()
+			if tc.dmi != nil {
+				require.NoError(t, os.WriteFile(filepath.Join(root, "sys_vendor"), []byte(tc.dmi[0]+"\n"), 0644))
+				require.NoError(t, os.WriteFile(filepath.Join(root, "product_name"), []byte(tc.dmi[1]+"\n"), 0644))
+			}
+
+			v, err := detectVirtualization(tc.manager, vgpu.NewVGPULib(unprivileged), pciDevicesMock{}, root)
+			require.NoError(t, err)
+
+			labels, err := newVGPULabeler(v, &eventRecorderMock{}).Labels()
+			require.NoError(t, err)
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
+
+func TestCompareDriverVersions(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"535.104.06", "535.104.06", 0},
+		{"535.54.06", "535.104.06", -1},
+		{"535.104.06", "525.60.12", 1},
+		{"460.16", "460.16.01", -1},
+		{"35.4.1", "unknown", -1},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, compareDriverVersions(tc.a, tc.b), "%s <=> %s", tc.a, tc.b)
+	}
+}
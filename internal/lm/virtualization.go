@@ -0,0 +1,228 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
+	"k8s.io/klog/v2"
+)
+
+// dmiRoot is the sysfs directory holding the DMI (SMBIOS) data of the system
+const dmiRoot = "/sys/class/dmi/id"
+
+// hypervisorDMIStrings are the substrings of the DMI system vendor or product name reported by common hypervisors
+var hypervisorDMIStrings = []string{
+	"KVM",
+	"QEMU",
+	"VMware",
+	"VirtualBox",
+	"Xen",
+	"Virtual Machine",
+	"OpenStack",
+	"Nutanix",
+}
+
+// virtualization holds what is known about the virtualization of the GPUs on the node. It is detected once
+// per labeling run and shared by the virtualization mode and vGPU labelers.
+type virtualization struct {
+	// vgpus are the vGPUs detected from the PCI configuration space.
+	vgpus []*vgpu.Device
+	// incompleteConfigSpace indicates that the PCI configuration space could not be read completely
+	// (i.e. GFD is not privileged), so vGPUs could not be detected from it.
+	incompleteConfigSpace bool
+	// nvmlModes are the virtualization modes reported by NVML. They are not queried if vGPUs were detected.
+	nvmlModes map[string]bool
+	// vgpuHost indicates that the mdev or SR-IOV sysfs entries of the GPUs identify a vGPU host.
+	vgpuHost bool
+	// virtualMachine indicates whether the DMI data identify a hypervisor or is nil if it cannot be read.
+	virtualMachine *bool
+}
+
+// detectVirtualization detects the virtualization of the GPUs on the node. vGPUs are detected from the PCI
+// configuration space. If there are none, the virtualization modes reported by NVML, the mdev and SR-IOV
+// sysfs entries and the DMI data are collected. Failures of the latter are logged and the corresponding
+// information is left unset.
+func detectVirtualization(manager resource.Manager, vgpuLib vgpu.Interface, pci vgpu.NvidiaPCI, dmiRoot string) (*virtualization, error) {
+	vgpus, err := vgpuLib.Devices()
+	if err != nil && !errors.Is(err, vgpu.ErrIncompleteConfigSpace) {
+		return nil, fmt.Errorf("unable to get vGPU devices: %v", err)
+	}
+	v := &virtualization{
+		vgpus:                 vgpus,
+		incompleteConfigSpace: err != nil,
+	}
+	if err != nil {
+		klog.Warningf("Unable to detect vGPUs from the PCI configuration space: %v", err)
+	}
+	if len(vgpus) > 0 {
+		return v, nil
+	}
+
+	v.nvmlModes, err = getVirtualizationModes(manager)
+	if err != nil {
+		klog.Warningf("Unable to get the virtualization mode of the GPUs: %v", err)
+	}
+
+	v.vgpuHost, err = isVGPUHost(pci)
+	if err != nil {
+		klog.Warningf("Unable to detect vGPU host: %v", err)
+	}
+
+	virtual, err := isVirtualMachine(dmiRoot)
+	if err != nil {
+		klog.Warningf("Unable to read DMI data: %v", err)
+	} else {
+		v.virtualMachine = &virtual
+	}
+
+	return v, nil
+}
+
+// mode returns the virtualization mode of the GPUs on the node, or an empty string if it cannot be determined.
+// vGPUs detected from the PCI configuration space take precedence, since the vGPU capability is only exposed to
+// guests. Otherwise the virtualization mode reported by NVML is used. If NVML cannot report it, the mdev and
+// SR-IOV sysfs entries identify vGPU hosts, and the DMI data distinguishes passthrough to a VM from bare metal.
+// A VM can only be identified as passthrough if the PCI configuration space could be read completely, since
+// a vGPU guest cannot be told apart from passthrough otherwise.
+func (v *virtualization) mode() string {
+	if len(v.vgpus) > 0 {
+		return resource.VirtualizationModeVGPU
+	}
+	if len(v.nvmlModes) > 1 {
+		return valueMixed
+	}
+	for mode := range v.nvmlModes {
+		return mode
+	}
+	if v.vgpuHost {
+		return resource.VirtualizationModeHostVGPU
+	}
+	switch {
+	case v.virtualMachine == nil:
+		return ""
+	case !*v.virtualMachine:
+		return resource.VirtualizationModeNone
+	case v.incompleteConfigSpace:
+		return ""
+	}
+	return resource.VirtualizationModePassthrough
+}
+
+// newVirtualizationModeLabeler creates a labeler for the virtualization mode of the GPUs on the node.
+// If the mode cannot be determined, no label is generated.
+func newVirtualizationModeLabeler(v *virtualization) Labeler {
+	mode := v.mode()
+	if mode == "" {
+		return empty{}
+	}
+
+	labels := Labels{
+		"nvidia.com/gpu.virtualization-mode": mode,
+	}
+	return labels
+}
+
+// getVirtualizationModes returns the set of virtualization modes reported by the devices of the manager.
+// Devices that do not report a known virtualization mode are skipped.
+func getVirtualizationModes(manager resource.Manager) (map[string]bool, error) {
+	if err := manager.Init(); err != nil {
+		return nil, fmt.Errorf("failed to initialize NVML: %v", err)
+	}
+	defer manager.Shutdown()
+
+	devices, err := manager.GetDevices()
+	if err != nil {
+		return nil, fmt.Errorf("error getting devices: %v", err)
+	}
+
+	modes := make(map[string]bool)
+	for _, d := range devices {
+		mode, err := d.GetVirtualizationMode()
+		if errors.Is(err, resource.ErrNotSupported) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting virtualization mode: %v", err)
+		}
+		if mode == resource.VirtualizationModeUnknown {
+			continue
+		}
+		modes[mode] = true
+	}
+	return modes, nil
+}
+
+// isVirtualMachine checks whether the DMI system vendor or product name identify a hypervisor
+func isVirtualMachine(root string) (bool, error) {
+	var dmi []string
+	for _, file := range []string{"sys_vendor", "product_name"} {
+		content, err := os.ReadFile(filepath.Join(root, file))
+		if err != nil {
+			return false, err
+		}
+		dmi = append(dmi, strings.TrimSpace(string(content)))
+	}
+
+	for _, s := range hypervisorDMIStrings {
+		for _, d := range dmi {
+			if strings.Contains(d, s) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// isVGPUHost checks whether the vGPU manager exposes vGPU types for any of the NVIDIA GPUs, or whether
+// SR-IOV virtual functions are enabled for any of them.
+func isVGPUHost(pci vgpu.NvidiaPCI) (bool, error) {
+	devices, err := pci.Devices()
+	if err != nil {
+		return false, fmt.Errorf("unable to get NVIDIA PCI devices: %v", err)
+	}
+
+	for _, d := range devices {
+		if d.Class != vgpu.PciDisplayControllerClass {
+			continue
+		}
+		types, err := d.GetMdevTypes()
+		if err != nil {
+			return false, err
+		}
+		if len(types) > 0 {
+			return true, nil
+		}
+		if d.IsVirtualFunction() {
+			continue
+		}
+		sriov, err := d.GetSriovInfo()
+		if err != nil {
+			return false, err
+		}
+		if sriov != nil && sriov.NumVFs > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
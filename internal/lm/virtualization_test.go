@@ -0,0 +1,184 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package lm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/resource"
+	rt "github.com/NVIDIA/gpu-feature-discovery/internal/resource/testing"
+	"github.com/NVIDIA/gpu-feature-discovery/internal/vgpu"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualizationModeLabeler(t *testing.T) {
+	nvmlDevice := func(mode string) resource.Device {
+		return rt.NewDeviceMock(false).WithVirtualizationMode(mode)
+	}
+	noNVML := rt.NewManagerMockWithDevices().WithErrorOnInit(fmt.Errorf("init failed"))
+
+	testCases := []struct {
+		description    string
+		manager        resource.Manager
+		pciDevices     []string
+		hostDevices    []vgpuHostDevice
+		dmi            []string
+		expectedLabels Labels
+	}{
+		{
+			description: "bare metal",
+			manager:     rt.NewManagerMockWithDevices(nvmlDevice(resource.VirtualizationModeNone)),
+			pciDevices:  []string{vgpu.MockPassthroughDevice},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "none",
+			},
+		},
+		{
+			description: "passthrough",
+			manager:     rt.NewManagerMockWithDevices(nvmlDevice(resource.VirtualizationModePassthrough)),
+			pciDevices:  []string{vgpu.MockPassthroughDevice},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "passthrough",
+			},
+		},
+		{
+			description: "vGPU guest detected from PCI capability",
+			manager:     rt.NewManagerMockWithDevices(nvmlDevice(resource.VirtualizationModeNone)),
+			pciDevices:  []string{vgpu.MockVGPUDevice},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "vgpu-guest",
+			},
+		},
+		{
+			description: "vGPU guest detected from NVML without privileges",
+			manager:     rt.NewManagerMockWithDevices(nvmlDevice(resource.VirtualizationModeVGPU)),
+			pciDevices:  []string{vgpu.MockUnprivilegedDevice},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "vgpu-guest",
+			},
+		},
+		{
+			description: "vGPU host",
+			manager:     rt.NewManagerMockWithDevices(nvmlDevice(resource.VirtualizationModeHostVGPU)),
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "host-vgpu",
+			},
+		},
+		{
+			description: "vSGA host",
+			manager:     rt.NewManagerMockWithDevices(nvmlDevice(resource.VirtualizationModeHostVSGA)),
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "host-vsga",
+			},
+		},
+		{
+			description: "mixed modes",
+			manager: rt.NewManagerMockWithDevices(
+				nvmlDevice(resource.VirtualizationModeNone),
+				nvmlDevice(resource.VirtualizationModePassthrough),
+			),
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "mixed",
+			},
+		},
+		{
+			description: "vGPU host detected from sysfs without NVML",
+			manager:     noNVML,
+			hostDevices: []vgpuHostDevice{
+				{
+					address: "0000:3b:00.0",
+					mdevTypes: []vgpu.MdevType{
+						{ID: "nvidia-222", Name: "GRID T4-1B", AvailableInstances: 16},
+					},
+				},
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "host-vgpu",
+			},
+		},
+		{
+			description: "SR-IOV vGPU host detected from sysfs without NVML",
+			manager:     noNVML,
+			hostDevices: []vgpuHostDevice{
+				{
+					address: "0000:3b:00.0",
+					sriov:   &vgpu.SriovInfo{TotalVFs: 16, NumVFs: 16},
+				},
+			},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "host-vgpu",
+			},
+		},
+		{
+			description: "passthrough detected from DMI without NVML",
+			manager:     noNVML,
+			pciDevices:  []string{vgpu.MockPassthroughDevice},
+			dmi:         []string{"QEMU", "Standard PC (Q35 + ICH9, 2009)"},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "passthrough",
+			},
+		},
+		{
+			description: "passthrough and vGPU guest ambiguous without privileges and NVML",
+			manager:     noNVML,
+			pciDevices:  []string{vgpu.MockUnprivilegedDevice},
+			dmi:         []string{"QEMU", "Standard PC (Q35 + ICH9, 2009)"},
+		},
+		{
+			description: "bare metal detected from DMI without NVML",
+			manager:     noNVML,
+			pciDevices:  []string{vgpu.MockPassthroughDevice},
+			dmi:         []string{"Supermicro", "AS -4124GO-NART"},
+			expectedLabels: Labels{
+				"nvidia.com/gpu.virtualization-mode": "none",
+			},
+		},
+		{
+			description: "unknown without NVML and DMI",
+			manager:     noNVML,
+			pciDevices:  []string{vgpu.MockPassthroughDevice},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			sysfs := t.TempDir()
+			var hostDevices pciDevicesMock
+			for _, d := range tc.hostDevices {
+				hostDevices = append(hostDevices, d.create(t, sysfs))
+			}
+
+			dmi := t.TempDir()
+			if tc.dmi != nil {
+				require.NoError(t, os.WriteFile(filepath.Join(dmi, "sys_vendor"), []byte(tc.dmi[0]+"\n"), 0644))
+				require.NoError(t, os.WriteFile(filepath.Join(dmi, "product_name"), []byte(tc.dmi[1]+"\n"), 0644))
+			}
+
+			vgpuLib := vgpu.NewVGPULib(vgpu.NewMockNvidiaPCIWithDevices(tc.pciDevices...))
+
+			v, err := detectVirtualization(tc.manager, vgpuLib, hostDevices, dmi)
+			require.NoError(t, err)
+
+			labels, err := newVirtualizationModeLabeler(v).Labels()
+			require.NoError(t, err)
+			require.EqualValues(t, tc.expectedLabels, labels)
+		})
+	}
+}
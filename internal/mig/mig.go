@@ -122,3 +122,24 @@ func (di *DeviceInfo) GetAllMigDevices() ([]resource.Device, error) {
 	}
 	return migs, nil
 }
+
+// GetRemainingMigCapacity returns, for each MIG profile, the number of additional instances that can be
+// created across all MIG-enabled devices given the existing GPU instance placements.
+func (di *DeviceInfo) GetRemainingMigCapacity() (map[string]int, error) {
+	devicesMap, err := di.GetDevicesMap()
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := make(map[string]int)
+	for _, d := range devicesMap[true] {
+		capacity, err := d.GetRemainingMigCapacity()
+		if err != nil {
+			return nil, err
+		}
+		for profile, count := range capacity {
+			remaining[profile] += count
+		}
+	}
+	return remaining, nil
+}
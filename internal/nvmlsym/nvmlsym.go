@@ -0,0 +1,61 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package nvmlsym checks which symbols are provided by the NVML library installed on the system.
+// Calling an NVML function that is not provided by the installed driver would abort the program,
+// so functions that were only added in recent drivers are checked before they are called.
+package nvmlsym
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/go-nvml/pkg/dl"
+)
+
+const (
+	libraryName      = "libnvidia-ml.so.1"
+	libraryLoadFlags = dl.RTLD_LAZY | dl.RTLD_GLOBAL
+)
+
+var (
+	mutex   sync.Mutex
+	symbols = make(map[string]bool)
+)
+
+// Has checks whether the NVML library on the system provides the specified symbol.
+// Each symbol is only resolved once; the result is reused for the lifetime of the process.
+func Has(symbol string) bool {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if found, ok := symbols[symbol]; ok {
+		return found
+	}
+	found := lookup(symbol)
+	symbols[symbol] = found
+	return found
+}
+
+// lookup resolves the specified symbol in the NVML library.
+func lookup(symbol string) bool {
+	lib := dl.New(libraryName, libraryLoadFlags)
+	if err := lib.Open(); err != nil {
+		return false
+	}
+	defer lib.Close()
+
+	return lib.Lookup(symbol) == nil
+}
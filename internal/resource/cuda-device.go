@@ -24,6 +24,9 @@ import (
 
 type cudaDevice cuda.Device
 
+// memoryTypeSystem is the memory type of integrated devices, which use system memory.
+const memoryTypeSystem = "system"
+
 var _ Device = (*cudaDevice)(nil)
 
 // NewCudaDevice constructs a new CUDA device
@@ -32,9 +35,61 @@ func NewCudaDevice(d cuda.Device) Device {
 	return &device
 }
 
-// GetAttributes is unsupported for CUDA devices
+// GetAttributes returns the attributes of a CUDA device. CUDA devices are only used on Tegra systems
+// and the cache.l2, integrated, unified-addressing, managed-memory, concurrent-kernels and memory.type
+// attributes are not reported for NVML devices. The L2 cache size is reported in KiB. The memory type
+// is only reported for integrated devices, which use system memory.
 func (d *cudaDevice) GetAttributes() (map[string]interface{}, error) {
-	return nil, fmt.Errorf("GetAttributes is not supported for CUDA devices")
+	multiprocessors, err := d.getAttribute(cuda.MULTIPROCESSOR_COUNT, "multiprocessor count")
+	if err != nil {
+		return nil, err
+	}
+	busWidth, err := d.getAttribute(cuda.GLOBAL_MEMORY_BUS_WIDTH, "memory bus width")
+	if err != nil {
+		return nil, err
+	}
+	l2CacheSize, err := d.getAttribute(cuda.L2_CACHE_SIZE, "L2 cache size")
+	if err != nil {
+		return nil, err
+	}
+	integrated, err := d.getAttribute(cuda.INTEGRATED, "integrated flag")
+	if err != nil {
+		return nil, err
+	}
+
+	a := map[string]interface{}{
+		"multiprocessors":  multiprocessors,
+		"memory.bus-width": busWidth,
+		"cache.l2":         l2CacheSize / 1024,
+		"integrated":       integrated != 0,
+	}
+	if integrated != 0 {
+		a["memory.type"] = memoryTypeSystem
+	}
+
+	capabilities := map[string]cuda.DeviceAttribute{
+		"unified-addressing": cuda.UNIFIED_ADDRESSING,
+		"managed-memory":     cuda.MANAGED_MEMORY,
+		"concurrent-kernels": cuda.CONCURRENT_KERNELS,
+	}
+	for name, attribute := range capabilities {
+		value, err := d.getAttribute(attribute, name+" support")
+		if err != nil {
+			return nil, err
+		}
+		a[name] = value != 0
+	}
+
+	return a, nil
+}
+
+// getAttribute returns the value of the specified CUDA device attribute.
+func (d *cudaDevice) getAttribute(attribute cuda.DeviceAttribute, description string) (int, error) {
+	value, r := cuda.Device(*d).GetAttribute(attribute)
+	if r != cuda.SUCCESS {
+		return 0, fmt.Errorf("failed to get %v for device: %v", description, r)
+	}
+	return value, nil
 }
 
 // GetCudaComputeCapability returns the CUDA Compute Capability major and minor versions.
@@ -53,6 +108,24 @@ func (d *cudaDevice) GetCudaComputeCapability() (int, int, error) {
 	return major, minor, nil
 }
 
+// GetComputeMode returns the compute mode of the device.
+func (d *cudaDevice) GetComputeMode() (string, error) {
+	mode, r := cuda.Device(*d).GetAttribute(cuda.COMPUTE_MODE)
+	if r != cuda.SUCCESS {
+		return "", fmt.Errorf("failed to get compute mode for device: result=%v", r)
+	}
+
+	switch cuda.ComputeMode(mode) {
+	case cuda.COMPUTEMODE_DEFAULT:
+		return ComputeModeDefault, nil
+	case cuda.COMPUTEMODE_PROHIBITED:
+		return ComputeModeProhibited, nil
+	case cuda.COMPUTEMODE_EXCLUSIVE_PROCESS:
+		return ComputeModeExclusiveProcess, nil
+	}
+	return ComputeModeUnknown, nil
+}
+
 // GetDeviceHandleFromMigDeviceHandle is unsupported for CUDA devices
 func (d *cudaDevice) GetDeviceHandleFromMigDeviceHandle() (Device, error) {
 	return nil, fmt.Errorf("GetDeviceHandleFromMigDeviceHandle is unsupported for CUDA devices")
@@ -84,7 +157,85 @@ func (d *cudaDevice) GetName() (string, error) {
 
 // GetUUID is unsupported for CUDA devices
 func (d *cudaDevice) GetUUID() (string, error) {
-	return "", fmt.Errorf("GetUUID is unsupported for CUDA devices")
+	return "", fmt.Errorf("GetUUID is %w for CUDA devices", ErrNotSupported)
+}
+
+// GetSerial is unsupported for CUDA devices
+func (d *cudaDevice) GetSerial() (string, error) {
+	return "", fmt.Errorf("GetSerial is %w for CUDA devices", ErrNotSupported)
+}
+
+// GetBoardPartNumber is unsupported for CUDA devices
+func (d *cudaDevice) GetBoardPartNumber() (string, error) {
+	return "", fmt.Errorf("GetBoardPartNumber is %w for CUDA devices", ErrNotSupported)
+}
+
+// GetPCIBusID is unsupported for CUDA devices
+func (d *cudaDevice) GetPCIBusID() (string, error) {
+	return "", fmt.Errorf("GetPCIBusID is %w for CUDA devices", ErrNotSupported)
+}
+
+// GetConfComputeProtectedMemoryMiB is unsupported for CUDA devices
+func (d *cudaDevice) GetConfComputeProtectedMemoryMiB() (uint64, error) {
+	return 0, fmt.Errorf("GetConfComputeProtectedMemoryMiB is %w for CUDA devices", ErrNotSupported)
+}
+
+// GetFabricInfo is unsupported for CUDA devices
+func (d *cudaDevice) GetFabricInfo() (FabricInfo, error) {
+	return FabricInfo{}, fmt.Errorf("GetFabricInfo is %w for CUDA devices", ErrNotSupported)
+}
+
+// IsPersistenceModeEnabled is unsupported for CUDA devices
+func (d *cudaDevice) IsPersistenceModeEnabled() (bool, error) {
+	return false, fmt.Errorf("IsPersistenceModeEnabled is %w for CUDA devices", ErrNotSupported)
+}
+
+// IsDisplayModeEnabled is unsupported for CUDA devices
+func (d *cudaDevice) IsDisplayModeEnabled() (bool, error) {
+	return false, fmt.Errorf("IsDisplayModeEnabled is %w for CUDA devices", ErrNotSupported)
+}
+
+// IsDisplayActive is unsupported for CUDA devices
+func (d *cudaDevice) IsDisplayActive() (bool, error) {
+	return false, fmt.Errorf("IsDisplayActive is %w for CUDA devices", ErrNotSupported)
+}
+
+// IsGspFirmwareEnabled is unsupported for CUDA devices
+func (d *cudaDevice) IsGspFirmwareEnabled() (bool, error) {
+	return false, fmt.Errorf("IsGspFirmwareEnabled is %w for CUDA devices", ErrNotSupported)
+}
+
+// GetVirtualizationMode is unsupported for CUDA devices
+func (d *cudaDevice) GetVirtualizationMode() (string, error) {
+	return "", fmt.Errorf("GetVirtualizationMode is %w for CUDA devices", ErrNotSupported)
+}
+
+// GetPowerLimitsW is unsupported for CUDA devices
+func (d *cudaDevice) GetPowerLimitsW() (uint32, uint32, error) {
+	return 0, 0, fmt.Errorf("GetPowerLimitsW is %w for CUDA devices", ErrNotSupported)
+}
+
+// GetMaxClocksMHz returns the peak SM and memory clocks of the device in MHz.
+func (d *cudaDevice) GetMaxClocksMHz() (uint32, uint32, error) {
+	smClockKHz, err := d.getAttribute(cuda.CLOCK_RATE, "peak SM clock")
+	if err != nil {
+		return 0, 0, err
+	}
+	memoryClockKHz, err := d.getAttribute(cuda.MEMORY_CLOCK_RATE, "peak memory clock")
+	if err != nil {
+		return 0, 0, err
+	}
+	return uint32(smClockKHz / 1000), uint32(memoryClockKHz / 1000), nil
+}
+
+// GetSupportedMigProfiles returns no profiles for CUDA devices
+func (d *cudaDevice) GetSupportedMigProfiles() ([]MigProfile, error) {
+	return nil, nil
+}
+
+// GetRemainingMigCapacity returns no capacity for CUDA devices
+func (d *cudaDevice) GetRemainingMigCapacity() (map[string]int, error) {
+	return nil, nil
 }
 
 // IsMigCapable always returns false for CUDA devices
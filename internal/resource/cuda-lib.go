@@ -18,17 +18,26 @@ package resource
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/NVIDIA/gpu-feature-discovery/internal/cuda"
 )
 
-type cudaLib struct{}
+type cudaLib struct {
+	tegraReleaseFile  string
+	driverVersionFile string
+}
 
 var _ Manager = (*cudaLib)(nil)
 
-// NewCudaManager returns an resource manger for CUDA devices
-func NewCudaManager() Manager {
-	return &cudaLib{}
+// NewCudaManager returns an resource manger for CUDA devices.
+// The L4T release and driver version files are read below the specified host root.
+func NewCudaManager(hostRoot string) Manager {
+	return &cudaLib{
+		tegraReleaseFile:  filepath.Join(hostRoot, tegraReleaseFile),
+		driverVersionFile: filepath.Join(hostRoot, DriverVersionFile),
+	}
 }
 
 // GetDevices returns the CUDA devices available on the system
@@ -63,10 +72,32 @@ func (l *cudaLib) GetCudaDriverVersion() (*uint, *uint, error) {
 	return &major, &minor, nil
 }
 
-// GetDriverVersion returns the driver version.
-// This is currently "unknown" for Tegra systems.
+// GetDriverVersion returns the version of the loaded kernel module.
+// Releases that do not report this (such as those based on the nvgpu driver) ship the driver as part
+// of L4T, so ErrNotSupported is returned and only the L4T version is available.
 func (l *cudaLib) GetDriverVersion() (string, error) {
-	return "unknown.unknown.unknown", nil
+	version, err := readDriverVersion(l.driverVersionFile)
+	if err != nil {
+		return "", fmt.Errorf("driver version is %w: unable to read %v: %v", ErrNotSupported, l.driverVersionFile, err)
+	}
+	return version, nil
+}
+
+// GetL4TVersion returns the version of the L4T (Linux for Tegra) release.
+func (l *cudaLib) GetL4TVersion() (string, error) {
+	version, err := readL4TVersion(l.tegraReleaseFile)
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("L4T release file %v is %w", l.tegraReleaseFile, ErrNotSupported)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error reading L4T release file %v: %v", l.tegraReleaseFile, err)
+	}
+	return version, nil
+}
+
+// GetConfComputeState is unsupported for CUDA-based systems
+func (l *cudaLib) GetConfComputeState() (ConfComputeState, error) {
+	return ConfComputeState{}, fmt.Errorf("GetConfComputeState is %w for CUDA-based systems", ErrNotSupported)
 }
 
 // Init initializes the CUDA library.
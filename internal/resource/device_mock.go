@@ -20,27 +20,75 @@ var _ Device = &DeviceMock{}
 //			GetAttributesFunc: func() (map[string]interface{}, error) {
 //				panic("mock out the GetAttributes method")
 //			},
+//			GetBoardPartNumberFunc: func() (string, error) {
+//				panic("mock out the GetBoardPartNumber method")
+//			},
+//			GetComputeModeFunc: func() (string, error) {
+//				panic("mock out the GetComputeMode method")
+//			},
+//			GetConfComputeProtectedMemoryMiBFunc: func() (uint64, error) {
+//				panic("mock out the GetConfComputeProtectedMemoryMiB method")
+//			},
 //			GetCudaComputeCapabilityFunc: func() (int, int, error) {
 //				panic("mock out the GetCudaComputeCapability method")
 //			},
 //			GetDeviceHandleFromMigDeviceHandleFunc: func() (Device, error) {
 //				panic("mock out the GetDeviceHandleFromMigDeviceHandle method")
 //			},
+//			GetFabricInfoFunc: func() (FabricInfo, error) {
+//				panic("mock out the GetFabricInfo method")
+//			},
+//			GetMaxClocksMHzFunc: func() (uint32, uint32, error) {
+//				panic("mock out the GetMaxClocksMHz method")
+//			},
 //			GetMigDevicesFunc: func() ([]Device, error) {
 //				panic("mock out the GetMigDevices method")
 //			},
 //			GetNameFunc: func() (string, error) {
 //				panic("mock out the GetName method")
 //			},
+//			GetPCIBusIDFunc: func() (string, error) {
+//				panic("mock out the GetPCIBusID method")
+//			},
+//			GetPowerLimitsWFunc: func() (uint32, uint32, error) {
+//				panic("mock out the GetPowerLimitsW method")
+//			},
+//			GetRemainingMigCapacityFunc: func() (map[string]int, error) {
+//				panic("mock out the GetRemainingMigCapacity method")
+//			},
+//			GetSerialFunc: func() (string, error) {
+//				panic("mock out the GetSerial method")
+//			},
+//			GetSupportedMigProfilesFunc: func() ([]MigProfile, error) {
+//				panic("mock out the GetSupportedMigProfiles method")
+//			},
 //			GetTotalMemoryMBFunc: func() (uint64, error) {
 //				panic("mock out the GetTotalMemoryMB method")
 //			},
+//			GetUUIDFunc: func() (string, error) {
+//				panic("mock out the GetUUID method")
+//			},
+//			GetVirtualizationModeFunc: func() (string, error) {
+//				panic("mock out the GetVirtualizationMode method")
+//			},
+//			IsDisplayActiveFunc: func() (bool, error) {
+//				panic("mock out the IsDisplayActive method")
+//			},
+//			IsDisplayModeEnabledFunc: func() (bool, error) {
+//				panic("mock out the IsDisplayModeEnabled method")
+//			},
+//			IsGspFirmwareEnabledFunc: func() (bool, error) {
+//				panic("mock out the IsGspFirmwareEnabled method")
+//			},
 //			IsMigCapableFunc: func() (bool, error) {
 //				panic("mock out the IsMigCapable method")
 //			},
 //			IsMigEnabledFunc: func() (bool, error) {
 //				panic("mock out the IsMigEnabled method")
 //			},
+//			IsPersistenceModeEnabledFunc: func() (bool, error) {
+//				panic("mock out the IsPersistenceModeEnabled method")
+//			},
 //		}
 //
 //		// use mockedDevice in code that requires Device
@@ -51,62 +99,174 @@ type DeviceMock struct {
 	// GetAttributesFunc mocks the GetAttributes method.
 	GetAttributesFunc func() (map[string]interface{}, error)
 
+	// GetBoardPartNumberFunc mocks the GetBoardPartNumber method.
+	GetBoardPartNumberFunc func() (string, error)
+
+	// GetComputeModeFunc mocks the GetComputeMode method.
+	GetComputeModeFunc func() (string, error)
+
+	// GetConfComputeProtectedMemoryMiBFunc mocks the GetConfComputeProtectedMemoryMiB method.
+	GetConfComputeProtectedMemoryMiBFunc func() (uint64, error)
+
 	// GetCudaComputeCapabilityFunc mocks the GetCudaComputeCapability method.
 	GetCudaComputeCapabilityFunc func() (int, int, error)
 
 	// GetDeviceHandleFromMigDeviceHandleFunc mocks the GetDeviceHandleFromMigDeviceHandle method.
 	GetDeviceHandleFromMigDeviceHandleFunc func() (Device, error)
 
+	// GetFabricInfoFunc mocks the GetFabricInfo method.
+	GetFabricInfoFunc func() (FabricInfo, error)
+
+	// GetMaxClocksMHzFunc mocks the GetMaxClocksMHz method.
+	GetMaxClocksMHzFunc func() (uint32, uint32, error)
+
 	// GetMigDevicesFunc mocks the GetMigDevices method.
 	GetMigDevicesFunc func() ([]Device, error)
 
 	// GetNameFunc mocks the GetName method.
 	GetNameFunc func() (string, error)
 
+	// GetPCIBusIDFunc mocks the GetPCIBusID method.
+	GetPCIBusIDFunc func() (string, error)
+
+	// GetPowerLimitsWFunc mocks the GetPowerLimitsW method.
+	GetPowerLimitsWFunc func() (uint32, uint32, error)
+
+	// GetRemainingMigCapacityFunc mocks the GetRemainingMigCapacity method.
+	GetRemainingMigCapacityFunc func() (map[string]int, error)
+
+	// GetSerialFunc mocks the GetSerial method.
+	GetSerialFunc func() (string, error)
+
+	// GetSupportedMigProfilesFunc mocks the GetSupportedMigProfiles method.
+	GetSupportedMigProfilesFunc func() ([]MigProfile, error)
+
 	// GetTotalMemoryMBFunc mocks the GetTotalMemoryMB method.
 	GetTotalMemoryMBFunc func() (uint64, error)
 
+	// GetUUIDFunc mocks the GetUUID method.
+	GetUUIDFunc func() (string, error)
+
+	// GetVirtualizationModeFunc mocks the GetVirtualizationMode method.
+	GetVirtualizationModeFunc func() (string, error)
+
+	// IsDisplayActiveFunc mocks the IsDisplayActive method.
+	IsDisplayActiveFunc func() (bool, error)
+
+	// IsDisplayModeEnabledFunc mocks the IsDisplayModeEnabled method.
+	IsDisplayModeEnabledFunc func() (bool, error)
+
+	// IsGspFirmwareEnabledFunc mocks the IsGspFirmwareEnabled method.
+	IsGspFirmwareEnabledFunc func() (bool, error)
+
 	// IsMigCapableFunc mocks the IsMigCapable method.
 	IsMigCapableFunc func() (bool, error)
 
 	// IsMigEnabledFunc mocks the IsMigEnabled method.
 	IsMigEnabledFunc func() (bool, error)
 
+	// IsPersistenceModeEnabledFunc mocks the IsPersistenceModeEnabled method.
+	IsPersistenceModeEnabledFunc func() (bool, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetAttributes holds details about calls to the GetAttributes method.
 		GetAttributes []struct {
 		}
+		// GetBoardPartNumber holds details about calls to the GetBoardPartNumber method.
+		GetBoardPartNumber []struct {
+		}
+		// GetComputeMode holds details about calls to the GetComputeMode method.
+		GetComputeMode []struct {
+		}
+		// GetConfComputeProtectedMemoryMiB holds details about calls to the GetConfComputeProtectedMemoryMiB method.
+		GetConfComputeProtectedMemoryMiB []struct {
+		}
 		// GetCudaComputeCapability holds details about calls to the GetCudaComputeCapability method.
 		GetCudaComputeCapability []struct {
 		}
 		// GetDeviceHandleFromMigDeviceHandle holds details about calls to the GetDeviceHandleFromMigDeviceHandle method.
 		GetDeviceHandleFromMigDeviceHandle []struct {
 		}
+		// GetFabricInfo holds details about calls to the GetFabricInfo method.
+		GetFabricInfo []struct {
+		}
+		// GetMaxClocksMHz holds details about calls to the GetMaxClocksMHz method.
+		GetMaxClocksMHz []struct {
+		}
 		// GetMigDevices holds details about calls to the GetMigDevices method.
 		GetMigDevices []struct {
 		}
 		// GetName holds details about calls to the GetName method.
 		GetName []struct {
 		}
+		// GetPCIBusID holds details about calls to the GetPCIBusID method.
+		GetPCIBusID []struct {
+		}
+		// GetPowerLimitsW holds details about calls to the GetPowerLimitsW method.
+		GetPowerLimitsW []struct {
+		}
+		// GetRemainingMigCapacity holds details about calls to the GetRemainingMigCapacity method.
+		GetRemainingMigCapacity []struct {
+		}
+		// GetSerial holds details about calls to the GetSerial method.
+		GetSerial []struct {
+		}
+		// GetSupportedMigProfiles holds details about calls to the GetSupportedMigProfiles method.
+		GetSupportedMigProfiles []struct {
+		}
 		// GetTotalMemoryMB holds details about calls to the GetTotalMemoryMB method.
 		GetTotalMemoryMB []struct {
 		}
+		// GetUUID holds details about calls to the GetUUID method.
+		GetUUID []struct {
+		}
+		// GetVirtualizationMode holds details about calls to the GetVirtualizationMode method.
+		GetVirtualizationMode []struct {
+		}
+		// IsDisplayActive holds details about calls to the IsDisplayActive method.
+		IsDisplayActive []struct {
+		}
+		// IsDisplayModeEnabled holds details about calls to the IsDisplayModeEnabled method.
+		IsDisplayModeEnabled []struct {
+		}
+		// IsGspFirmwareEnabled holds details about calls to the IsGspFirmwareEnabled method.
+		IsGspFirmwareEnabled []struct {
+		}
 		// IsMigCapable holds details about calls to the IsMigCapable method.
 		IsMigCapable []struct {
 		}
 		// IsMigEnabled holds details about calls to the IsMigEnabled method.
 		IsMigEnabled []struct {
 		}
+		// IsPersistenceModeEnabled holds details about calls to the IsPersistenceModeEnabled method.
+		IsPersistenceModeEnabled []struct {
+		}
 	}
 	lockGetAttributes                      sync.RWMutex
+	lockGetBoardPartNumber                 sync.RWMutex
+	lockGetComputeMode                     sync.RWMutex
+	lockGetConfComputeProtectedMemoryMiB   sync.RWMutex
 	lockGetCudaComputeCapability           sync.RWMutex
 	lockGetDeviceHandleFromMigDeviceHandle sync.RWMutex
+	lockGetFabricInfo                      sync.RWMutex
+	lockGetMaxClocksMHz                    sync.RWMutex
 	lockGetMigDevices                      sync.RWMutex
 	lockGetName                            sync.RWMutex
+	lockGetPCIBusID                        sync.RWMutex
+	lockGetPowerLimitsW                    sync.RWMutex
+	lockGetRemainingMigCapacity            sync.RWMutex
+	lockGetSerial                          sync.RWMutex
+	lockGetSupportedMigProfiles            sync.RWMutex
 	lockGetTotalMemoryMB                   sync.RWMutex
+	lockGetUUID                            sync.RWMutex
+	lockGetVirtualizationMode              sync.RWMutex
+	lockIsDisplayActive                    sync.RWMutex
+	lockIsDisplayModeEnabled               sync.RWMutex
+	lockIsGspFirmwareEnabled               sync.RWMutex
 	lockIsMigCapable                       sync.RWMutex
 	lockIsMigEnabled                       sync.RWMutex
+	lockIsPersistenceModeEnabled           sync.RWMutex
 }
 
 // GetAttributes calls GetAttributesFunc.
@@ -136,6 +296,87 @@ func (mock *DeviceMock) GetAttributesCalls() []struct {
 	return calls
 }
 
+// GetBoardPartNumber calls GetBoardPartNumberFunc.
+func (mock *DeviceMock) GetBoardPartNumber() (string, error) {
+	if mock.GetBoardPartNumberFunc == nil {
+		panic("DeviceMock.GetBoardPartNumberFunc: method is nil but Device.GetBoardPartNumber was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetBoardPartNumber.Lock()
+	mock.calls.GetBoardPartNumber = append(mock.calls.GetBoardPartNumber, callInfo)
+	mock.lockGetBoardPartNumber.Unlock()
+	return mock.GetBoardPartNumberFunc()
+}
+
+// GetBoardPartNumberCalls gets all the calls that were made to GetBoardPartNumber.
+// Check the length with:
+//
+//	len(mockedDevice.GetBoardPartNumberCalls())
+func (mock *DeviceMock) GetBoardPartNumberCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetBoardPartNumber.RLock()
+	calls = mock.calls.GetBoardPartNumber
+	mock.lockGetBoardPartNumber.RUnlock()
+	return calls
+}
+
+// GetComputeMode calls GetComputeModeFunc.
+func (mock *DeviceMock) GetComputeMode() (string, error) {
+	if mock.GetComputeModeFunc == nil {
+		panic("DeviceMock.GetComputeModeFunc: method is nil but Device.GetComputeMode was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetComputeMode.Lock()
+	mock.calls.GetComputeMode = append(mock.calls.GetComputeMode, callInfo)
+	mock.lockGetComputeMode.Unlock()
+	return mock.GetComputeModeFunc()
+}
+
+// GetComputeModeCalls gets all the calls that were made to GetComputeMode.
+// Check the length with:
+//
+//	len(mockedDevice.GetComputeModeCalls())
+func (mock *DeviceMock) GetComputeModeCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetComputeMode.RLock()
+	calls = mock.calls.GetComputeMode
+	mock.lockGetComputeMode.RUnlock()
+	return calls
+}
+
+// GetConfComputeProtectedMemoryMiB calls GetConfComputeProtectedMemoryMiBFunc.
+func (mock *DeviceMock) GetConfComputeProtectedMemoryMiB() (uint64, error) {
+	if mock.GetConfComputeProtectedMemoryMiBFunc == nil {
+		panic("DeviceMock.GetConfComputeProtectedMemoryMiBFunc: method is nil but Device.GetConfComputeProtectedMemoryMiB was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetConfComputeProtectedMemoryMiB.Lock()
+	mock.calls.GetConfComputeProtectedMemoryMiB = append(mock.calls.GetConfComputeProtectedMemoryMiB, callInfo)
+	mock.lockGetConfComputeProtectedMemoryMiB.Unlock()
+	return mock.GetConfComputeProtectedMemoryMiBFunc()
+}
+
+// GetConfComputeProtectedMemoryMiBCalls gets all the calls that were made to GetConfComputeProtectedMemoryMiB.
+// Check the length with:
+//
+//	len(mockedDevice.GetConfComputeProtectedMemoryMiBCalls())
+func (mock *DeviceMock) GetConfComputeProtectedMemoryMiBCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetConfComputeProtectedMemoryMiB.RLock()
+	calls = mock.calls.GetConfComputeProtectedMemoryMiB
+	mock.lockGetConfComputeProtectedMemoryMiB.RUnlock()
+	return calls
+}
+
 // GetCudaComputeCapability calls GetCudaComputeCapabilityFunc.
 func (mock *DeviceMock) GetCudaComputeCapability() (int, int, error) {
 	if mock.GetCudaComputeCapabilityFunc == nil {
@@ -190,6 +431,60 @@ func (mock *DeviceMock) GetDeviceHandleFromMigDeviceHandleCalls() []struct {
 	return calls
 }
 
+// GetFabricInfo calls GetFabricInfoFunc.
+func (mock *DeviceMock) GetFabricInfo() (FabricInfo, error) {
+	if mock.GetFabricInfoFunc == nil {
+		panic("DeviceMock.GetFabricInfoFunc: method is nil but Device.GetFabricInfo was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetFabricInfo.Lock()
+	mock.calls.GetFabricInfo = append(mock.calls.GetFabricInfo, callInfo)
+	mock.lockGetFabricInfo.Unlock()
+	return mock.GetFabricInfoFunc()
+}
+
+// GetFabricInfoCalls gets all the calls that were made to GetFabricInfo.
+// Check the length with:
+//
+//	len(mockedDevice.GetFabricInfoCalls())
+func (mock *DeviceMock) GetFabricInfoCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetFabricInfo.RLock()
+	calls = mock.calls.GetFabricInfo
+	mock.lockGetFabricInfo.RUnlock()
+	return calls
+}
+
+// GetMaxClocksMHz calls GetMaxClocksMHzFunc.
+func (mock *DeviceMock) GetMaxClocksMHz() (uint32, uint32, error) {
+	if mock.GetMaxClocksMHzFunc == nil {
+		panic("DeviceMock.GetMaxClocksMHzFunc: method is nil but Device.GetMaxClocksMHz was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetMaxClocksMHz.Lock()
+	mock.calls.GetMaxClocksMHz = append(mock.calls.GetMaxClocksMHz, callInfo)
+	mock.lockGetMaxClocksMHz.Unlock()
+	return mock.GetMaxClocksMHzFunc()
+}
+
+// GetMaxClocksMHzCalls gets all the calls that were made to GetMaxClocksMHz.
+// Check the length with:
+//
+//	len(mockedDevice.GetMaxClocksMHzCalls())
+func (mock *DeviceMock) GetMaxClocksMHzCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetMaxClocksMHz.RLock()
+	calls = mock.calls.GetMaxClocksMHz
+	mock.lockGetMaxClocksMHz.RUnlock()
+	return calls
+}
+
 // GetMigDevices calls GetMigDevicesFunc.
 func (mock *DeviceMock) GetMigDevices() ([]Device, error) {
 	if mock.GetMigDevicesFunc == nil {
@@ -244,6 +539,141 @@ func (mock *DeviceMock) GetNameCalls() []struct {
 	return calls
 }
 
+// GetPCIBusID calls GetPCIBusIDFunc.
+func (mock *DeviceMock) GetPCIBusID() (string, error) {
+	if mock.GetPCIBusIDFunc == nil {
+		panic("DeviceMock.GetPCIBusIDFunc: method is nil but Device.GetPCIBusID was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetPCIBusID.Lock()
+	mock.calls.GetPCIBusID = append(mock.calls.GetPCIBusID, callInfo)
+	mock.lockGetPCIBusID.Unlock()
+	return mock.GetPCIBusIDFunc()
+}
+
+// GetPCIBusIDCalls gets all the calls that were made to GetPCIBusID.
+// Check the length with:
+//
+//	len(mockedDevice.GetPCIBusIDCalls())
+func (mock *DeviceMock) GetPCIBusIDCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetPCIBusID.RLock()
+	calls = mock.calls.GetPCIBusID
+	mock.lockGetPCIBusID.RUnlock()
+	return calls
+}
+
+// GetPowerLimitsW calls GetPowerLimitsWFunc.
+func (mock *DeviceMock) GetPowerLimitsW() (uint32, uint32, error) {
+	if mock.GetPowerLimitsWFunc == nil {
+		panic("DeviceMock.GetPowerLimitsWFunc: method is nil but Device.GetPowerLimitsW was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetPowerLimitsW.Lock()
+	mock.calls.GetPowerLimitsW = append(mock.calls.GetPowerLimitsW, callInfo)
+	mock.lockGetPowerLimitsW.Unlock()
+	return mock.GetPowerLimitsWFunc()
+}
+
+// GetPowerLimitsWCalls gets all the calls that were made to GetPowerLimitsW.
+// Check the length with:
+//
+//	len(mockedDevice.GetPowerLimitsWCalls())
+func (mock *DeviceMock) GetPowerLimitsWCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetPowerLimitsW.RLock()
+	calls = mock.calls.GetPowerLimitsW
+	mock.lockGetPowerLimitsW.RUnlock()
+	return calls
+}
+
+// GetRemainingMigCapacity calls GetRemainingMigCapacityFunc.
+func (mock *DeviceMock) GetRemainingMigCapacity() (map[string]int, error) {
+	if mock.GetRemainingMigCapacityFunc == nil {
+		panic("DeviceMock.GetRemainingMigCapacityFunc: method is nil but Device.GetRemainingMigCapacity was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetRemainingMigCapacity.Lock()
+	mock.calls.GetRemainingMigCapacity = append(mock.calls.GetRemainingMigCapacity, callInfo)
+	mock.lockGetRemainingMigCapacity.Unlock()
+	return mock.GetRemainingMigCapacityFunc()
+}
+
+// GetRemainingMigCapacityCalls gets all the calls that were made to GetRemainingMigCapacity.
+// Check the length with:
+//
+//	len(mockedDevice.GetRemainingMigCapacityCalls())
+func (mock *DeviceMock) GetRemainingMigCapacityCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetRemainingMigCapacity.RLock()
+	calls = mock.calls.GetRemainingMigCapacity
+	mock.lockGetRemainingMigCapacity.RUnlock()
+	return calls
+}
+
+// GetSerial calls GetSerialFunc.
+func (mock *DeviceMock) GetSerial() (string, error) {
+	if mock.GetSerialFunc == nil {
+		panic("DeviceMock.GetSerialFunc: method is nil but Device.GetSerial was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetSerial.Lock()
+	mock.calls.GetSerial = append(mock.calls.GetSerial, callInfo)
+	mock.lockGetSerial.Unlock()
+	return mock.GetSerialFunc()
+}
+
+// GetSerialCalls gets all the calls that were made to GetSerial.
+// Check the length with:
+//
+//	len(mockedDevice.GetSerialCalls())
+func (mock *DeviceMock) GetSerialCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetSerial.RLock()
+	calls = mock.calls.GetSerial
+	mock.lockGetSerial.RUnlock()
+	return calls
+}
+
+// GetSupportedMigProfiles calls GetSupportedMigProfilesFunc.
+func (mock *DeviceMock) GetSupportedMigProfiles() ([]MigProfile, error) {
+	if mock.GetSupportedMigProfilesFunc == nil {
+		panic("DeviceMock.GetSupportedMigProfilesFunc: method is nil but Device.GetSupportedMigProfiles was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetSupportedMigProfiles.Lock()
+	mock.calls.GetSupportedMigProfiles = append(mock.calls.GetSupportedMigProfiles, callInfo)
+	mock.lockGetSupportedMigProfiles.Unlock()
+	return mock.GetSupportedMigProfilesFunc()
+}
+
+// GetSupportedMigProfilesCalls gets all the calls that were made to GetSupportedMigProfiles.
+// Check the length with:
+//
+//	len(mockedDevice.GetSupportedMigProfilesCalls())
+func (mock *DeviceMock) GetSupportedMigProfilesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetSupportedMigProfiles.RLock()
+	calls = mock.calls.GetSupportedMigProfiles
+	mock.lockGetSupportedMigProfiles.RUnlock()
+	return calls
+}
+
 // GetTotalMemoryMB calls GetTotalMemoryMBFunc.
 func (mock *DeviceMock) GetTotalMemoryMB() (uint64, error) {
 	if mock.GetTotalMemoryMBFunc == nil {
@@ -271,6 +701,141 @@ func (mock *DeviceMock) GetTotalMemoryMBCalls() []struct {
 	return calls
 }
 
+// GetUUID calls GetUUIDFunc.
+func (mock *DeviceMock) GetUUID() (string, error) {
+	if mock.GetUUIDFunc == nil {
+		panic("DeviceMock.GetUUIDFunc: method is nil but Device.GetUUID was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetUUID.Lock()
+	mock.calls.GetUUID = append(mock.calls.GetUUID, callInfo)
+	mock.lockGetUUID.Unlock()
+	return mock.GetUUIDFunc()
+}
+
+// GetUUIDCalls gets all the calls that were made to GetUUID.
+// Check the length with:
+//
+//	len(mockedDevice.GetUUIDCalls())
+func (mock *DeviceMock) GetUUIDCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetUUID.RLock()
+	calls = mock.calls.GetUUID
+	mock.lockGetUUID.RUnlock()
+	return calls
+}
+
+// GetVirtualizationMode calls GetVirtualizationModeFunc.
+func (mock *DeviceMock) GetVirtualizationMode() (string, error) {
+	if mock.GetVirtualizationModeFunc == nil {
+		panic("DeviceMock.GetVirtualizationModeFunc: method is nil but Device.GetVirtualizationMode was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetVirtualizationMode.Lock()
+	mock.calls.GetVirtualizationMode = append(mock.calls.GetVirtualizationMode, callInfo)
+	mock.lockGetVirtualizationMode.Unlock()
+	return mock.GetVirtualizationModeFunc()
+}
+
+// GetVirtualizationModeCalls gets all the calls that were made to GetVirtualizationMode.
+// Check the length with:
+//
+//	len(mockedDevice.GetVirtualizationModeCalls())
+func (mock *DeviceMock) GetVirtualizationModeCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetVirtualizationMode.RLock()
+	calls = mock.calls.GetVirtualizationMode
+	mock.lockGetVirtualizationMode.RUnlock()
+	return calls
+}
+
+// IsDisplayActive calls IsDisplayActiveFunc.
+func (mock *DeviceMock) IsDisplayActive() (bool, error) {
+	if mock.IsDisplayActiveFunc == nil {
+		panic("DeviceMock.IsDisplayActiveFunc: method is nil but Device.IsDisplayActive was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockIsDisplayActive.Lock()
+	mock.calls.IsDisplayActive = append(mock.calls.IsDisplayActive, callInfo)
+	mock.lockIsDisplayActive.Unlock()
+	return mock.IsDisplayActiveFunc()
+}
+
+// IsDisplayActiveCalls gets all the calls that were made to IsDisplayActive.
+// Check the length with:
+//
+//	len(mockedDevice.IsDisplayActiveCalls())
+func (mock *DeviceMock) IsDisplayActiveCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockIsDisplayActive.RLock()
+	calls = mock.calls.IsDisplayActive
+	mock.lockIsDisplayActive.RUnlock()
+	return calls
+}
+
+// IsDisplayModeEnabled calls IsDisplayModeEnabledFunc.
+func (mock *DeviceMock) IsDisplayModeEnabled() (bool, error) {
+	if mock.IsDisplayModeEnabledFunc == nil {
+		panic("DeviceMock.IsDisplayModeEnabledFunc: method is nil but Device.IsDisplayModeEnabled was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockIsDisplayModeEnabled.Lock()
+	mock.calls.IsDisplayModeEnabled = append(mock.calls.IsDisplayModeEnabled, callInfo)
+	mock.lockIsDisplayModeEnabled.Unlock()
+	return mock.IsDisplayModeEnabledFunc()
+}
+
+// IsDisplayModeEnabledCalls gets all the calls that were made to IsDisplayModeEnabled.
+// Check the length with:
+//
+//	len(mockedDevice.IsDisplayModeEnabledCalls())
+func (mock *DeviceMock) IsDisplayModeEnabledCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockIsDisplayModeEnabled.RLock()
+	calls = mock.calls.IsDisplayModeEnabled
+	mock.lockIsDisplayModeEnabled.RUnlock()
+	return calls
+}
+
+// IsGspFirmwareEnabled calls IsGspFirmwareEnabledFunc.
+func (mock *DeviceMock) IsGspFirmwareEnabled() (bool, error) {
+	if mock.IsGspFirmwareEnabledFunc == nil {
+		panic("DeviceMock.IsGspFirmwareEnabledFunc: method is nil but Device.IsGspFirmwareEnabled was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockIsGspFirmwareEnabled.Lock()
+	mock.calls.IsGspFirmwareEnabled = append(mock.calls.IsGspFirmwareEnabled, callInfo)
+	mock.lockIsGspFirmwareEnabled.Unlock()
+	return mock.IsGspFirmwareEnabledFunc()
+}
+
+// IsGspFirmwareEnabledCalls gets all the calls that were made to IsGspFirmwareEnabled.
+// Check the length with:
+//
+//	len(mockedDevice.IsGspFirmwareEnabledCalls())
+func (mock *DeviceMock) IsGspFirmwareEnabledCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockIsGspFirmwareEnabled.RLock()
+	calls = mock.calls.IsGspFirmwareEnabled
+	mock.lockIsGspFirmwareEnabled.RUnlock()
+	return calls
+}
+
 // IsMigCapable calls IsMigCapableFunc.
 func (mock *DeviceMock) IsMigCapable() (bool, error) {
 	if mock.IsMigCapableFunc == nil {
@@ -324,3 +889,30 @@ func (mock *DeviceMock) IsMigEnabledCalls() []struct {
 	mock.lockIsMigEnabled.RUnlock()
 	return calls
 }
+
+// IsPersistenceModeEnabled calls IsPersistenceModeEnabledFunc.
+func (mock *DeviceMock) IsPersistenceModeEnabled() (bool, error) {
+	if mock.IsPersistenceModeEnabledFunc == nil {
+		panic("DeviceMock.IsPersistenceModeEnabledFunc: method is nil but Device.IsPersistenceModeEnabled was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockIsPersistenceModeEnabled.Lock()
+	mock.calls.IsPersistenceModeEnabled = append(mock.calls.IsPersistenceModeEnabled, callInfo)
+	mock.lockIsPersistenceModeEnabled.Unlock()
+	return mock.IsPersistenceModeEnabledFunc()
+}
+
+// IsPersistenceModeEnabledCalls gets all the calls that were made to IsPersistenceModeEnabled.
+// Check the length with:
+//
+//	len(mockedDevice.IsPersistenceModeEnabledCalls())
+func (mock *DeviceMock) IsPersistenceModeEnabledCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockIsPersistenceModeEnabled.RLock()
+	calls = mock.calls.IsPersistenceModeEnabled
+	mock.lockIsPersistenceModeEnabled.RUnlock()
+	return calls
+}
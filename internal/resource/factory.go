@@ -23,8 +23,9 @@ import (
 )
 
 // NewManager is a factory method that creates a resource Manager based on the specified config.
+// Files from the host filesystem are read below the host root of the config.
 func NewManager(config *spec.Config) Manager {
-	return WithConfig(getManager(), config)
+	return WithConfig(getManager(*config.Flags.GFD.HostRoot), config)
 }
 
 // WithConfig modifies a manager depending on the specified config.
@@ -38,7 +39,7 @@ func WithConfig(manager Manager, config *spec.Config) Manager {
 }
 
 // getManager returns the resource manager depending on the system configuration.
-func getManager() Manager {
+func getManager(hostRoot string) Manager {
 	// logWithReason logs the output of the has* / is* checks from the info.Interface
 	logWithReason := func(f func() (bool, string), tag string) bool {
 		is, reason := f()
@@ -65,7 +66,7 @@ func getManager() Manager {
 		return NewNVMLManager()
 	} else if isTegra {
 		klog.Info("Using CUDA manager")
-		return NewCudaManager()
+		return NewCudaManager(hostRoot)
 	}
 
 	klog.Warning("No valid resources detected; using empty manager.")
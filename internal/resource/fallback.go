@@ -62,3 +62,13 @@ func (m *withFallBack) GetCudaDriverVersion() (*uint, *uint, error) {
 func (m *withFallBack) GetDriverVersion() (string, error) {
 	return m.wraps.GetDriverVersion()
 }
+
+// GetConfComputeState delegates to the wrapped manager
+func (m *withFallBack) GetConfComputeState() (ConfComputeState, error) {
+	return m.wraps.GetConfComputeState()
+}
+
+// GetL4TVersion delegates to the wrapped manager
+func (m *withFallBack) GetL4TVersion() (string, error) {
+	return m.wraps.GetL4TVersion()
+}
@@ -17,6 +17,9 @@ var _ Manager = &ManagerMock{}
 //
 //		// make and configure a mocked Manager
 //		mockedManager := &ManagerMock{
+//			GetConfComputeStateFunc: func() (ConfComputeState, error) {
+//				panic("mock out the GetConfComputeState method")
+//			},
 //			GetCudaDriverVersionFunc: func() (*uint, *uint, error) {
 //				panic("mock out the GetCudaDriverVersion method")
 //			},
@@ -26,6 +29,9 @@ var _ Manager = &ManagerMock{}
 //			GetDriverVersionFunc: func() (string, error) {
 //				panic("mock out the GetDriverVersion method")
 //			},
+//			GetL4TVersionFunc: func() (string, error) {
+//				panic("mock out the GetL4TVersion method")
+//			},
 //			InitFunc: func() error {
 //				panic("mock out the Init method")
 //			},
@@ -39,6 +45,9 @@ var _ Manager = &ManagerMock{}
 //
 //	}
 type ManagerMock struct {
+	// GetConfComputeStateFunc mocks the GetConfComputeState method.
+	GetConfComputeStateFunc func() (ConfComputeState, error)
+
 	// GetCudaDriverVersionFunc mocks the GetCudaDriverVersion method.
 	GetCudaDriverVersionFunc func() (*uint, *uint, error)
 
@@ -48,6 +57,9 @@ type ManagerMock struct {
 	// GetDriverVersionFunc mocks the GetDriverVersion method.
 	GetDriverVersionFunc func() (string, error)
 
+	// GetL4TVersionFunc mocks the GetL4TVersion method.
+	GetL4TVersionFunc func() (string, error)
+
 	// InitFunc mocks the Init method.
 	InitFunc func() error
 
@@ -56,6 +68,9 @@ type ManagerMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// GetConfComputeState holds details about calls to the GetConfComputeState method.
+		GetConfComputeState []struct {
+		}
 		// GetCudaDriverVersion holds details about calls to the GetCudaDriverVersion method.
 		GetCudaDriverVersion []struct {
 		}
@@ -65,6 +80,9 @@ type ManagerMock struct {
 		// GetDriverVersion holds details about calls to the GetDriverVersion method.
 		GetDriverVersion []struct {
 		}
+		// GetL4TVersion holds details about calls to the GetL4TVersion method.
+		GetL4TVersion []struct {
+		}
 		// Init holds details about calls to the Init method.
 		Init []struct {
 		}
@@ -72,13 +90,42 @@ type ManagerMock struct {
 		Shutdown []struct {
 		}
 	}
+	lockGetConfComputeState  sync.RWMutex
 	lockGetCudaDriverVersion sync.RWMutex
 	lockGetDevices           sync.RWMutex
 	lockGetDriverVersion     sync.RWMutex
+	lockGetL4TVersion        sync.RWMutex
 	lockInit                 sync.RWMutex
 	lockShutdown             sync.RWMutex
 }
 
+// GetConfComputeState calls GetConfComputeStateFunc.
+func (mock *ManagerMock) GetConfComputeState() (ConfComputeState, error) {
+	if mock.GetConfComputeStateFunc == nil {
+		panic("ManagerMock.GetConfComputeStateFunc: method is nil but Manager.GetConfComputeState was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetConfComputeState.Lock()
+	mock.calls.GetConfComputeState = append(mock.calls.GetConfComputeState, callInfo)
+	mock.lockGetConfComputeState.Unlock()
+	return mock.GetConfComputeStateFunc()
+}
+
+// GetConfComputeStateCalls gets all the calls that were made to GetConfComputeState.
+// Check the length with:
+//
+//	len(mockedManager.GetConfComputeStateCalls())
+func (mock *ManagerMock) GetConfComputeStateCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetConfComputeState.RLock()
+	calls = mock.calls.GetConfComputeState
+	mock.lockGetConfComputeState.RUnlock()
+	return calls
+}
+
 // GetCudaDriverVersion calls GetCudaDriverVersionFunc.
 func (mock *ManagerMock) GetCudaDriverVersion() (*uint, *uint, error) {
 	if mock.GetCudaDriverVersionFunc == nil {
@@ -160,6 +207,33 @@ func (mock *ManagerMock) GetDriverVersionCalls() []struct {
 	return calls
 }
 
+// GetL4TVersion calls GetL4TVersionFunc.
+func (mock *ManagerMock) GetL4TVersion() (string, error) {
+	if mock.GetL4TVersionFunc == nil {
+		panic("ManagerMock.GetL4TVersionFunc: method is nil but Manager.GetL4TVersion was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetL4TVersion.Lock()
+	mock.calls.GetL4TVersion = append(mock.calls.GetL4TVersion, callInfo)
+	mock.lockGetL4TVersion.Unlock()
+	return mock.GetL4TVersionFunc()
+}
+
+// GetL4TVersionCalls gets all the calls that were made to GetL4TVersion.
+// Check the length with:
+//
+//	len(mockedManager.GetL4TVersionCalls())
+func (mock *ManagerMock) GetL4TVersionCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetL4TVersion.RLock()
+	calls = mock.calls.GetL4TVersion
+	mock.lockGetL4TVersion.RUnlock()
+	return calls
+}
+
 // Init calls InitFunc.
 func (mock *ManagerMock) Init() error {
 	if mock.InitFunc == nil {
@@ -55,3 +55,13 @@ func (l *null) GetCudaDriverVersion() (*uint, *uint, error) {
 func (l *null) GetDriverVersion() (string, error) {
 	return "", fmt.Errorf("GetDriverVersion is unsupported")
 }
+
+// GetConfComputeState is not supported
+func (l *null) GetConfComputeState() (ConfComputeState, error) {
+	return ConfComputeState{}, fmt.Errorf("GetConfComputeState is %w", ErrNotSupported)
+}
+
+// GetL4TVersion is not supported
+func (l *null) GetL4TVersion() (string, error) {
+	return "", fmt.Errorf("GetL4TVersion is %w", ErrNotSupported)
+}
@@ -17,8 +17,14 @@
 package resource
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/NVIDIA/gpu-feature-discovery/internal/confcompute"
+	"github.com/NVIDIA/gpu-feature-discovery/internal/nvmlsym"
+
+	gonvml "github.com/NVIDIA/go-nvml/pkg/nvml"
 	"gitlab.com/nvidia/cloud-native/go-nvlib/pkg/nvlib/device"
 	"gitlab.com/nvidia/cloud-native/go-nvlib/pkg/nvml"
 )
@@ -59,9 +65,494 @@ func (d nvmlDevice) GetCudaComputeCapability() (int, int, error) {
 	return major, minor, nil
 }
 
-// GetAttributes is only supported for MIG devices.
+// GetComputeMode returns the compute mode of the device.
+func (d nvmlDevice) GetComputeMode() (string, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return "", err
+	}
+
+	mode, ret := handle.GetComputeMode()
+	if ret != gonvml.SUCCESS {
+		return "", nvmlError(ret)
+	}
+
+	switch mode {
+	case gonvml.COMPUTEMODE_DEFAULT:
+		return ComputeModeDefault, nil
+	case gonvml.COMPUTEMODE_EXCLUSIVE_THREAD:
+		return ComputeModeExclusiveThread, nil
+	case gonvml.COMPUTEMODE_PROHIBITED:
+		return ComputeModeProhibited, nil
+	case gonvml.COMPUTEMODE_EXCLUSIVE_PROCESS:
+		return ComputeModeExclusiveProcess, nil
+	}
+	return ComputeModeUnknown, nil
+}
+
+// IsPersistenceModeEnabled checks whether persistence mode is enabled for the device.
+func (d nvmlDevice) IsPersistenceModeEnabled() (bool, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return false, err
+	}
+
+	mode, ret := handle.GetPersistenceMode()
+	if ret != gonvml.SUCCESS {
+		return false, nvmlError(ret)
+	}
+	return mode == gonvml.FEATURE_ENABLED, nil
+}
+
+// IsDisplayModeEnabled checks whether a physical display is connected to the device.
+func (d nvmlDevice) IsDisplayModeEnabled() (bool, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return false, err
+	}
+
+	mode, ret := handle.GetDisplayMode()
+	if ret != gonvml.SUCCESS {
+		return false, nvmlError(ret)
+	}
+	return mode == gonvml.FEATURE_ENABLED, nil
+}
+
+// IsDisplayActive checks whether a display is initialized on the device.
+func (d nvmlDevice) IsDisplayActive() (bool, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return false, err
+	}
+
+	active, ret := handle.GetDisplayActive()
+	if ret != gonvml.SUCCESS {
+		return false, nvmlError(ret)
+	}
+	return active == gonvml.FEATURE_ENABLED, nil
+}
+
+// IsGspFirmwareEnabled checks whether the GPU System Processor (GSP) firmware is in use for the device.
+func (d nvmlDevice) IsGspFirmwareEnabled() (bool, error) {
+	if !nvmlsym.Has("nvmlDeviceGetGspFirmwareMode") {
+		return false, fmt.Errorf("IsGspFirmwareEnabled is %w by the installed driver", ErrNotSupported)
+	}
+
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return false, err
+	}
+
+	enabled, _, ret := handle.GetGspFirmwareMode()
+	if ret != gonvml.SUCCESS {
+		return false, nvmlError(ret)
+	}
+	return enabled, nil
+}
+
+// GetConfComputeProtectedMemoryMiB returns the size of the protected memory of the device in confidential computing mode.
+// If the device does not support confidential computing, ErrNotSupported is returned.
+func (d nvmlDevice) GetConfComputeProtectedMemoryMiB() (uint64, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return 0, err
+	}
+
+	info, ret := confcompute.DeviceGetConfComputeMemSizeInfo(handle)
+	if ret == gonvml.ERROR_FUNCTION_NOT_FOUND {
+		return 0, fmt.Errorf("GetConfComputeProtectedMemoryMiB is %w by the installed driver", ErrNotSupported)
+	}
+	if ret != gonvml.SUCCESS {
+		return 0, nvmlError(ret)
+	}
+	return info.ProtectedMemSizeKib / 1024, nil
+}
+
+// GetVirtualizationMode returns the virtualization mode of the device as reported by the driver.
+func (d nvmlDevice) GetVirtualizationMode() (string, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return "", err
+	}
+
+	mode, ret := handle.GetVirtualizationMode()
+	if ret != gonvml.SUCCESS {
+		return "", nvmlError(ret)
+	}
+
+	switch mode {
+	case gonvml.GPU_VIRTUALIZATION_MODE_NONE:
+		return VirtualizationModeNone, nil
+	case gonvml.GPU_VIRTUALIZATION_MODE_PASSTHROUGH:
+		return VirtualizationModePassthrough, nil
+	case gonvml.GPU_VIRTUALIZATION_MODE_VGPU:
+		return VirtualizationModeVGPU, nil
+	case gonvml.GPU_VIRTUALIZATION_MODE_HOST_VGPU:
+		return VirtualizationModeHostVGPU, nil
+	case gonvml.GPU_VIRTUALIZATION_MODE_HOST_VSGA:
+		return VirtualizationModeHostVSGA, nil
+	}
+	return VirtualizationModeUnknown, nil
+}
+
+// GetPowerLimitsW returns the enforced and default power limits of the device in W.
+func (d nvmlDevice) GetPowerLimitsW() (uint32, uint32, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	enforced, ret := handle.GetEnforcedPowerLimit()
+	if ret != gonvml.SUCCESS {
+		return 0, 0, nvmlError(ret)
+	}
+
+	defaultLimit, ret := handle.GetPowerManagementDefaultLimit()
+	if ret != gonvml.SUCCESS {
+		return 0, 0, nvmlError(ret)
+	}
+
+	// NVML reports power limits in mW
+	return enforced / 1000, defaultLimit / 1000, nil
+}
+
+// GetMaxClocksMHz returns the maximum SM and memory clocks of the device in MHz.
+func (d nvmlDevice) GetMaxClocksMHz() (uint32, uint32, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sm, ret := handle.GetMaxClockInfo(gonvml.CLOCK_SM)
+	if ret != gonvml.SUCCESS {
+		return 0, 0, nvmlError(ret)
+	}
+
+	memory, ret := handle.GetMaxClockInfo(gonvml.CLOCK_MEM)
+	if ret != gonvml.SUCCESS {
+		return 0, 0, nvmlError(ret)
+	}
+
+	return sm, memory, nil
+}
+
+// GetUUID returns the UUID of the device.
+func (d nvmlDevice) GetUUID() (string, error) {
+	uuid, ret := d.Device.GetUUID()
+	if ret != nvml.SUCCESS {
+		return "", ret
+	}
+	return uuid, nil
+}
+
+// GetSerial returns the serial number of the device.
+func (d nvmlDevice) GetSerial() (string, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return "", err
+	}
+
+	serial, ret := handle.GetSerial()
+	if ret != gonvml.SUCCESS {
+		return "", nvmlError(ret)
+	}
+	return serial, nil
+}
+
+// GetBoardPartNumber returns the board part number of the device.
+func (d nvmlDevice) GetBoardPartNumber() (string, error) {
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return "", err
+	}
+
+	partNumber, ret := handle.GetBoardPartNumber()
+	if ret != gonvml.SUCCESS {
+		return "", nvmlError(ret)
+	}
+	return partNumber, nil
+}
+
+// GetPCIBusID returns the PCI bus ID of the device (e.g. 00000000:3B:00.0).
+func (d nvmlDevice) GetPCIBusID() (string, error) {
+	info, ret := d.Device.GetPciInfo()
+	if ret != nvml.SUCCESS {
+		return "", ret
+	}
+
+	var busID []byte
+	for _, b := range info.BusId {
+		if b == 0 {
+			break
+		}
+		busID = append(busID, byte(b))
+	}
+	return string(busID), nil
+}
+
+// GetFabricInfo returns the GPU fabric registration state, cluster UUID, and clique ID of the device.
+func (d nvmlDevice) GetFabricInfo() (FabricInfo, error) {
+	// Older drivers do not provide the fabric info API at all.
+	if !nvmlsym.Has("nvmlDeviceGetGpuFabricInfo") {
+		return FabricInfo{}, fmt.Errorf("GetFabricInfo is %w by the installed driver", ErrNotSupported)
+	}
+
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return FabricInfo{}, err
+	}
+
+	info, ret := handle.GetGpuFabricInfo()
+	if ret != gonvml.SUCCESS {
+		return FabricInfo{}, nvmlError(ret)
+	}
+
+	fabric := FabricInfo{
+		ClusterUUID: fabricClusterUUID(info.ClusterUuid),
+		CliqueID:    info.PartitionId,
+	}
+	switch info.State {
+	case gonvml.GPU_FABRIC_STATE_NOT_STARTED:
+		fabric.State = FabricStateNotStarted
+	case gonvml.GPU_FABRIC_STATE_IN_PROGRESS:
+		fabric.State = FabricStateInProgress
+	case gonvml.GPU_FABRIC_STATE_COMPLETED:
+		fabric.State = FabricStateCompleted
+		if gonvml.Return(info.Status) != gonvml.SUCCESS {
+			fabric.State = FabricStateFailed
+		}
+	default:
+		return FabricInfo{}, ErrNotSupported
+	}
+	return fabric, nil
+}
+
+// GetSupportedMigProfiles returns the GPU instance and compute instance profiles supported by a MIG-capable device.
+// The GPU instance profiles are returned irrespective of whether MIG is enabled on the device. NVML only reports
+// the compute instance profiles of an existing GPU instance, so a compute instance profile is only returned if
+// MIG is enabled and a GPU instance of the matching profile exists.
+func (d nvmlDevice) GetSupportedMigProfiles() ([]MigProfile, error) {
+	capable, err := d.Device.IsMigCapable()
+	if err != nil {
+		return nil, fmt.Errorf("error checking if GPU is MIG capable: %v", err)
+	}
+	if !capable {
+		return nil, nil
+	}
+
+	enabled, err := d.Device.IsMigEnabled()
+	if err != nil {
+		return nil, fmt.Errorf("error checking if MIG is enabled: %v", err)
+	}
+
+	profiles, err := d.Device.GetMigProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("error getting MIG profiles: %v", err)
+	}
+
+	var supported []MigProfile
+	for _, p := range profiles {
+		info := p.GetInfo()
+		if info.CIEngProfileID != 0 {
+			continue
+		}
+
+		giProfileInfo, ret := d.Device.GetGpuInstanceProfileInfo(info.GIProfileID)
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("error getting GPU instance profile info for %v: %v", p, ret)
+		}
+
+		maxInstances := int(giProfileInfo.InstanceCount)
+		if info.C != info.G {
+			if !enabled {
+				continue
+			}
+			ciInstanceCount, err := getComputeInstanceCount(d.Device, &giProfileInfo, info)
+			if err != nil {
+				return nil, fmt.Errorf("error getting compute instance count for %v: %v", p, err)
+			}
+			if ciInstanceCount == 0 {
+				continue
+			}
+			maxInstances *= ciInstanceCount
+		}
+
+		supported = append(supported, MigProfile{
+			Name:         migProfileName(p),
+			MaxInstances: maxInstances,
+		})
+	}
+	return supported, nil
+}
+
+// GetRemainingMigCapacity returns the number of additional instances of each GPU instance profile that can
+// be created on a MIG-enabled device given the existing GPU instance placements.
+// If MIG is not enabled on the device, nil is returned.
+func (d nvmlDevice) GetRemainingMigCapacity() (map[string]int, error) {
+	enabled, err := d.Device.IsMigEnabled()
+	if err != nil {
+		return nil, fmt.Errorf("error checking if MIG is enabled: %v", err)
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := d.getGpuInstanceProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := make(map[string]int)
+	for _, p := range profiles {
+		giProfileInfo, ret := handle.GetGpuInstanceProfileInfo(p.GetInfo().GIProfileID)
+		if ret != gonvml.SUCCESS {
+			return nil, fmt.Errorf("error getting GPU instance profile info for %v: %v", p, nvmlError(ret))
+		}
+
+		capacity, ret := handle.GetGpuInstanceRemainingCapacity(&giProfileInfo)
+		if ret != gonvml.SUCCESS {
+			return nil, fmt.Errorf("error getting remaining capacity for %v: %v", p, nvmlError(ret))
+		}
+		remaining[migProfileName(p)] = capacity
+	}
+	return remaining, nil
+}
+
+// getGpuInstanceProfiles returns the MIG profiles of the device that correspond to GPU instance profiles
+// (i.e. the profiles using the default compute instance that spans the whole GPU instance).
+func (d nvmlDevice) getGpuInstanceProfiles() ([]device.MigProfile, error) {
+	profiles, err := d.Device.GetMigProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("error getting MIG profiles: %v", err)
+	}
+
+	var giProfiles []device.MigProfile
+	for _, p := range profiles {
+		info := p.GetInfo()
+		if info.C != info.G || info.CIEngProfileID != 0 {
+			continue
+		}
+		giProfiles = append(giProfiles, p)
+	}
+	return giProfiles, nil
+}
+
+// getComputeInstanceCount returns the maximum number of compute instances of a compute instance profile in a
+// GPU instance of the specified profile as reported by NVML for an existing GPU instance.
+// If no GPU instance of the profile exists or the compute instance profile is not supported, 0 is returned.
+func getComputeInstanceCount(d nvml.Device, giProfileInfo *nvml.GpuInstanceProfileInfo, info device.MigProfileInfo) (int, error) {
+	gis, ret := d.GetGpuInstances(giProfileInfo)
+	if ret != nvml.SUCCESS {
+		return 0, fmt.Errorf("error getting GPU instances: %v", ret)
+	}
+	if len(gis) == 0 {
+		return 0, nil
+	}
+
+	ciProfileInfo, ret := gis[0].GetComputeInstanceProfileInfo(info.CIProfileID, info.CIEngProfileID)
+	if ret == nvml.ERROR_NOT_SUPPORTED {
+		return 0, nil
+	}
+	if ret != nvml.SUCCESS {
+		return 0, fmt.Errorf("error getting compute instance profile info: %v", ret)
+	}
+	return int(ciProfileInfo.InstanceCount), nil
+}
+
+// migProfileName returns the name of a MIG profile with the '+' separating the attributes replaced by a '.'
+// so that the name can be used in label keys.
+func migProfileName(p device.MigProfile) string {
+	return strings.ReplaceAll(p.String(), "+", ".")
+}
+
+// GetAttributes returns the attributes of a full GPU.
+// NVML only reports the multiprocessor and engine counts in the GPU instance profiles of MIG-capable
+// devices, where they are taken from the profile spanning the whole GPU. The encoder capacity for
+// each codec is reported as a percentage of the maximum encoder capacity. NVML does not report the
+// L2 cache size or the memory type of a device.
+// Attributes that are not supported are omitted.
 func (d nvmlDevice) GetAttributes() (map[string]interface{}, error) {
-	return nil, fmt.Errorf("GetAttributes is not supported for non-MIG devices")
+	handle, err := d.getNvmlDevice()
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]interface{})
+
+	engines, err := d.getFullGpuInstanceProfileInfo(handle)
+	if err != nil && !errors.Is(err, ErrNotSupported) {
+		return nil, err
+	}
+	if err == nil {
+		attributes["multiprocessors"] = engines.MultiprocessorCount
+		attributes["engines.copy"] = engines.CopyEngineCount
+		attributes["engines.decoder"] = engines.DecoderCount
+		attributes["engines.encoder"] = engines.EncoderCount
+		attributes["engines.jpeg"] = engines.JpegCount
+		attributes["engines.ofa"] = engines.OfaCount
+	}
+
+	codecs := map[string]gonvml.EncoderType{
+		"h264": gonvml.ENCODER_QUERY_H264,
+		"hevc": gonvml.ENCODER_QUERY_HEVC,
+	}
+	for codec, encoderType := range codecs {
+		capacity, ret := handle.GetEncoderCapacity(encoderType)
+		if ret == gonvml.ERROR_NOT_SUPPORTED {
+			continue
+		}
+		if ret != gonvml.SUCCESS {
+			return nil, fmt.Errorf("error getting %v encoder capacity: %v", codec, nvmlError(ret))
+		}
+		attributes["engines.encoder.capacity."+codec] = capacity
+	}
+
+	busWidth, ret := handle.GetMemoryBusWidth()
+	if ret != gonvml.SUCCESS && ret != gonvml.ERROR_NOT_SUPPORTED {
+		return nil, fmt.Errorf("error getting memory bus width: %v", nvmlError(ret))
+	}
+	if ret == gonvml.SUCCESS && busWidth != 0 {
+		attributes["memory.bus-width"] = busWidth
+	}
+
+	return attributes, nil
+}
+
+// getFullGpuInstanceProfileInfo returns the info for the GPU instance profile with the largest number of
+// slices. This profile spans the whole GPU and its multiprocessor and engine counts are those of the full GPU.
+// ErrNotSupported is returned if the device is not MIG capable since NVML does not report these counts for
+// other devices.
+func (d nvmlDevice) getFullGpuInstanceProfileInfo(handle gonvml.Device) (gonvml.GpuInstanceProfileInfo, error) {
+	capable, err := d.Device.IsMigCapable()
+	if err != nil {
+		return gonvml.GpuInstanceProfileInfo{}, fmt.Errorf("error checking if GPU is MIG capable: %v", err)
+	}
+	if !capable {
+		return gonvml.GpuInstanceProfileInfo{}, fmt.Errorf("engine counts are %w for non-MIG-capable devices", ErrNotSupported)
+	}
+
+	var full gonvml.GpuInstanceProfileInfo
+	for profile := 0; profile < gonvml.GPU_INSTANCE_PROFILE_COUNT; profile++ {
+		info, ret := handle.GetGpuInstanceProfileInfo(profile)
+		if ret == gonvml.ERROR_NOT_SUPPORTED || ret == gonvml.ERROR_INVALID_ARGUMENT {
+			continue
+		}
+		if ret != gonvml.SUCCESS {
+			return gonvml.GpuInstanceProfileInfo{}, fmt.Errorf("error getting GPU instance profile info for profile %d: %v", profile, nvmlError(ret))
+		}
+		if info.SliceCount > full.SliceCount {
+			full = info
+		}
+	}
+	if full.SliceCount == 0 {
+		return gonvml.GpuInstanceProfileInfo{}, fmt.Errorf("no GPU instance profiles found: %w", ErrNotSupported)
+	}
+	return full, nil
 }
 
 // GetDeviceHandleFromMigDeviceHandle is only supported for MIG devices
@@ -86,3 +577,37 @@ func (d nvmlDevice) GetTotalMemoryMB() (uint64, error) {
 	}
 	return info.Total / (1024 * 1024), nil
 }
+
+// getNvmlDevice returns the go-nvml handle for the device.
+// The go-nvlib device only exposes a subset of the NVML API, so the handle is
+// looked up by UUID when other device properties are queried.
+func (d nvmlDevice) getNvmlDevice() (gonvml.Device, error) {
+	uuid, ret := d.Device.GetUUID()
+	if ret != nvml.SUCCESS {
+		return gonvml.Device{}, fmt.Errorf("failed to get device UUID: %v", ret)
+	}
+
+	handle, r := gonvml.DeviceGetHandleByUUID(uuid)
+	if r != gonvml.SUCCESS {
+		return gonvml.Device{}, fmt.Errorf("failed to get device handle for %v: %v", uuid, nvml.Return(r))
+	}
+	return handle, nil
+}
+
+// nvmlError converts an NVML return value to an error.
+// If the return value indicates that a query is not supported, ErrNotSupported is returned.
+func nvmlError(ret gonvml.Return) error {
+	if ret == gonvml.ERROR_NOT_SUPPORTED {
+		return ErrNotSupported
+	}
+	return nvml.Return(ret)
+}
+
+// fabricClusterUUID formats the raw cluster UUID of a GPU fabric.
+func fabricClusterUUID(raw [gonvml.GPU_FABRIC_UUID_LEN]int8) string {
+	var b [gonvml.GPU_FABRIC_UUID_LEN]byte
+	for i, v := range raw {
+		b[i] = byte(v)
+	}
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,85 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/nvidia/cloud-native/go-nvlib/pkg/nvlib/device"
+	"gitlab.com/nvidia/cloud-native/go-nvlib/pkg/nvml"
+)
+
+func TestGetComputeInstanceCount(t *testing.T) {
+	// A 3g.40gb GPU instance holding three 1c.3g.40gb or a single 2c.3g.40gb compute instance
+	gi := &nvml.GpuInstanceMock{
+		GetComputeInstanceProfileInfoFunc: func(profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, nvml.Return) {
+			switch profile {
+			case nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE:
+				return nvml.ComputeInstanceProfileInfo{InstanceCount: 3}, nvml.SUCCESS
+			case nvml.COMPUTE_INSTANCE_PROFILE_2_SLICE:
+				return nvml.ComputeInstanceProfileInfo{InstanceCount: 1}, nvml.SUCCESS
+			}
+			return nvml.ComputeInstanceProfileInfo{}, nvml.ERROR_NOT_SUPPORTED
+		},
+	}
+
+	testCases := []struct {
+		description string
+		gis         []nvml.GpuInstance
+		info        device.MigProfileInfo
+		expected    int
+	}{
+		{
+			description: "compute instance profile of an existing GPU instance",
+			gis:         []nvml.GpuInstance{gi},
+			info:        device.MigProfileInfo{C: 1, G: 3, GB: 40, CIProfileID: nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE},
+			expected:    3,
+		},
+		{
+			description: "compute instance count is not derived from the slice counts",
+			gis:         []nvml.GpuInstance{gi},
+			info:        device.MigProfileInfo{C: 2, G: 3, GB: 40, CIProfileID: nvml.COMPUTE_INSTANCE_PROFILE_2_SLICE},
+			expected:    1,
+		},
+		{
+			description: "unsupported compute instance profile",
+			gis:         []nvml.GpuInstance{gi},
+			info:        device.MigProfileInfo{C: 4, G: 3, GB: 40, CIProfileID: nvml.COMPUTE_INSTANCE_PROFILE_4_SLICE},
+			expected:    0,
+		},
+		{
+			description: "no GPU instance of the profile",
+			info:        device.MigProfileInfo{C: 1, G: 3, GB: 40, CIProfileID: nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE},
+			expected:    0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			d := &nvml.DeviceMock{
+				GetGpuInstancesFunc: func(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstance, nvml.Return) {
+					return tc.gis, nvml.SUCCESS
+				},
+			}
+
+			count, err := getComputeInstanceCount(d, &nvml.GpuInstanceProfileInfo{}, tc.info)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, count)
+		})
+	}
+}
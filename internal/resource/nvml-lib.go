@@ -17,6 +17,11 @@
 package resource
 
 import (
+	"fmt"
+
+	"github.com/NVIDIA/gpu-feature-discovery/internal/confcompute"
+
+	gonvml "github.com/NVIDIA/go-nvml/pkg/nvml"
 	"gitlab.com/nvidia/cloud-native/go-nvlib/pkg/nvlib/device"
 	"gitlab.com/nvidia/cloud-native/go-nvlib/pkg/nvml"
 )
@@ -78,6 +83,37 @@ func (l nvmlLib) GetDriverVersion() (string, error) {
 	return v, nil
 }
 
+// GetConfComputeState returns the confidential computing mode of the system and whether the GPUs are ready to accept work
+func (l nvmlLib) GetConfComputeState() (ConfComputeState, error) {
+	state, ret := confcompute.SystemGetConfComputeState()
+	if ret == gonvml.ERROR_FUNCTION_NOT_FOUND {
+		return ConfComputeState{}, ErrNotSupported
+	}
+	if ret != gonvml.SUCCESS {
+		return ConfComputeState{}, nvmlError(ret)
+	}
+
+	cc := ConfComputeState{
+		Mode: ConfComputeModeOff,
+	}
+	if state.CCFeature != confcompute.CC_SYSTEM_FEATURE_ENABLED {
+		return cc, nil
+	}
+
+	cc.Mode = ConfComputeModeOn
+	if state.DevToolsMode == confcompute.CC_SYSTEM_DEVTOOLS_MODE_ON {
+		cc.Mode = ConfComputeModeDevTools
+	}
+
+	ready, ret := confcompute.SystemGetConfComputeGpusReadyState()
+	if ret != gonvml.SUCCESS {
+		return ConfComputeState{}, nvmlError(ret)
+	}
+	cc.Ready = ready
+
+	return cc, nil
+}
+
 // Init initialises the library
 func (l nvmlLib) Init() error {
 	ret := l.Interface.Init()
@@ -95,3 +131,8 @@ func (l nvmlLib) Shutdown() error {
 	}
 	return nil
 }
+
+// GetL4TVersion is unsupported for NVML-based systems
+func (l nvmlLib) GetL4TVersion() (string, error) {
+	return "", fmt.Errorf("GetL4TVersion is %w for NVML-based systems", ErrNotSupported)
+}
@@ -91,6 +91,90 @@ func (d nvmlMigDevice) GetCudaComputeCapability() (int, int, error) {
 	return 0, 0, fmt.Errorf("GetCudaComputeCapability is not supported for MIG devices")
 }
 
+// GetComputeMode is not supported for MIG devices
+func (d nvmlMigDevice) GetComputeMode() (string, error) {
+	return "", fmt.Errorf("GetComputeMode is %w for MIG devices", ErrNotSupported)
+}
+
+// IsPersistenceModeEnabled is not supported for MIG devices
+func (d nvmlMigDevice) IsPersistenceModeEnabled() (bool, error) {
+	return false, fmt.Errorf("IsPersistenceModeEnabled is %w for MIG devices", ErrNotSupported)
+}
+
+// IsDisplayModeEnabled is not supported for MIG devices
+func (d nvmlMigDevice) IsDisplayModeEnabled() (bool, error) {
+	return false, fmt.Errorf("IsDisplayModeEnabled is %w for MIG devices", ErrNotSupported)
+}
+
+// IsDisplayActive is not supported for MIG devices
+func (d nvmlMigDevice) IsDisplayActive() (bool, error) {
+	return false, fmt.Errorf("IsDisplayActive is %w for MIG devices", ErrNotSupported)
+}
+
+// IsGspFirmwareEnabled is not supported for MIG devices
+func (d nvmlMigDevice) IsGspFirmwareEnabled() (bool, error) {
+	return false, fmt.Errorf("IsGspFirmwareEnabled is %w for MIG devices", ErrNotSupported)
+}
+
+// GetVirtualizationMode is not supported for MIG devices
+func (d nvmlMigDevice) GetVirtualizationMode() (string, error) {
+	return "", fmt.Errorf("GetVirtualizationMode is %w for MIG devices", ErrNotSupported)
+}
+
+// GetPowerLimitsW is not supported for MIG devices
+func (d nvmlMigDevice) GetPowerLimitsW() (uint32, uint32, error) {
+	return 0, 0, fmt.Errorf("GetPowerLimitsW is %w for MIG devices", ErrNotSupported)
+}
+
+// GetMaxClocksMHz is not supported for MIG devices
+func (d nvmlMigDevice) GetMaxClocksMHz() (uint32, uint32, error) {
+	return 0, 0, fmt.Errorf("GetMaxClocksMHz is %w for MIG devices", ErrNotSupported)
+}
+
+// GetUUID returns the UUID of the MIG device.
+func (d nvmlMigDevice) GetUUID() (string, error) {
+	uuid, ret := d.MigDevice.GetUUID()
+	if ret != nvml.SUCCESS {
+		return "", ret
+	}
+	return uuid, nil
+}
+
+// GetSerial is not supported for MIG devices
+func (d nvmlMigDevice) GetSerial() (string, error) {
+	return "", fmt.Errorf("GetSerial is %w for MIG devices", ErrNotSupported)
+}
+
+// GetBoardPartNumber is not supported for MIG devices
+func (d nvmlMigDevice) GetBoardPartNumber() (string, error) {
+	return "", fmt.Errorf("GetBoardPartNumber is %w for MIG devices", ErrNotSupported)
+}
+
+// GetPCIBusID is not supported for MIG devices
+func (d nvmlMigDevice) GetPCIBusID() (string, error) {
+	return "", fmt.Errorf("GetPCIBusID is %w for MIG devices", ErrNotSupported)
+}
+
+// GetConfComputeProtectedMemoryMiB is not supported for MIG devices
+func (d nvmlMigDevice) GetConfComputeProtectedMemoryMiB() (uint64, error) {
+	return 0, fmt.Errorf("GetConfComputeProtectedMemoryMiB is %w for MIG devices", ErrNotSupported)
+}
+
+// GetFabricInfo is not supported for MIG devices
+func (d nvmlMigDevice) GetFabricInfo() (FabricInfo, error) {
+	return FabricInfo{}, fmt.Errorf("GetFabricInfo is %w for MIG devices", ErrNotSupported)
+}
+
+// GetSupportedMigProfiles is not supported for MIG devices
+func (d nvmlMigDevice) GetSupportedMigProfiles() ([]MigProfile, error) {
+	return nil, fmt.Errorf("GetSupportedMigProfiles is %w for MIG devices", ErrNotSupported)
+}
+
+// GetRemainingMigCapacity is not supported for MIG devices
+func (d nvmlMigDevice) GetRemainingMigCapacity() (map[string]int, error) {
+	return nil, fmt.Errorf("GetRemainingMigCapacity is %w for MIG devices", ErrNotSupported)
+}
+
 // GetName returns the name of the nvmlMigDevice.
 // This is equal to the mig profile.
 func (d nvmlMigDevice) GetName() (string, error) {
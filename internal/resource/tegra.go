@@ -0,0 +1,102 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package resource
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const (
+	// tegraReleaseFile contains the L4T release information on Tegra-based systems.
+	tegraReleaseFile = "/etc/nv_tegra_release"
+	// DriverVersionFile contains the version of the loaded NVIDIA kernel module.
+	DriverVersionFile = "/proc/driver/nvidia/version"
+)
+
+// tegraReleaseRegexp matches the release and revision in the first line of the L4T release file, e.g.
+//
+//	# R35 (release), REVISION: 4.1, GCID: 33958178, BOARD: t186ref, EABI: aarch64, DATE: Tue Aug  1 19:57:35 UTC 2023
+var tegraReleaseRegexp = regexp.MustCompile(`^#\s*R(\d+)\s+\(release\),\s*REVISION:\s*(\d+(?:\.\d+)*)`)
+
+// driverVersionRegexp matches a driver version such as 540.4.0 or 535.104.05.
+var driverVersionRegexp = regexp.MustCompile(`^\d+\.\d+(\.\d+)?$`)
+
+// readL4TVersion reads the L4T version (e.g. 35.4.1) from the specified release file.
+func readL4TVersion(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return parseL4TVersion(f)
+}
+
+// parseL4TVersion parses the L4T version from the contents of an L4T release file.
+func parseL4TVersion(r io.Reader) (string, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		match := tegraReleaseRegexp.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		return match[1] + "." + match[2], nil
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("error reading L4T release: %v", err)
+	}
+	return "", fmt.Errorf("no L4T release found")
+}
+
+// readDriverVersion reads the version of the NVIDIA kernel module from the specified version file.
+func readDriverVersion(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return parseDriverVersion(f)
+}
+
+// parseDriverVersion parses the driver version from the NVRM line of a driver version file, e.g.
+//
+//	NVRM version: NVIDIA UNIX Open Kernel Module for aarch64  540.4.0  Release Build  (buildbrain@mobile-u64-6336-d8000)  Tue Jul 23 13:40:51 PDT 2024
+func parseDriverVersion(r io.Reader) (string, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "NVRM version:") {
+			continue
+		}
+		for _, field := range strings.Fields(line) {
+			if driverVersionRegexp.MatchString(field) {
+				return field, nil
+			}
+		}
+		return "", fmt.Errorf("no driver version found in %q", line)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("error reading driver version: %v", err)
+	}
+	return "", fmt.Errorf("no NVRM version found")
+}
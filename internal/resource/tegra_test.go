@@ -0,0 +1,193 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package resource
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseL4TVersion(t *testing.T) {
+	testCases := []struct {
+		description     string
+		contents        string
+		expectedVersion string
+		expectedError   bool
+	}{
+		{
+			description:     "r35 release",
+			contents:        "# R35 (release), REVISION: 4.1, GCID: 33958178, BOARD: t186ref, EABI: aarch64, DATE: Tue Aug  1 19:57:35 UTC 2023\n",
+			expectedVersion: "35.4.1",
+		},
+		{
+			description:     "r32 release",
+			contents:        "# R32 (release), REVISION: 7.4, GCID: 33514132, BOARD: t210ref, EABI: aarch64, DATE: Fri Jun  9 04:25:08 UTC 2023\n",
+			expectedVersion: "32.7.4",
+		},
+		{
+			description:   "empty file",
+			expectedError: true,
+		},
+		{
+			description:   "invalid contents",
+			contents:      "INSTALL_TYPE=\n",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			version, err := parseL4TVersion(strings.NewReader(tc.contents))
+			if tc.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedVersion, version)
+		})
+	}
+}
+
+func TestParseDriverVersion(t *testing.T) {
+	testCases := []struct {
+		description     string
+		contents        string
+		expectedVersion string
+		expectedError   bool
+	}{
+		{
+			description:     "open kernel module",
+			contents:        "NVRM version: NVIDIA UNIX Open Kernel Module for aarch64  540.4.0  Release Build  (buildbrain@mobile-u64-6336-d8000)  Tue Jul 23 13:40:51 PDT 2024\n",
+			expectedVersion: "540.4.0",
+		},
+		{
+			description:     "proprietary kernel module",
+			contents:        "NVRM version: NVIDIA UNIX x86_64 Kernel Module  535.104.05  Sat Aug 19 01:15:15 UTC 2023\nGCC version:  gcc version 11.4.0\n",
+			expectedVersion: "535.104.05",
+		},
+		{
+			description:   "no version",
+			contents:      "NVRM version: NVIDIA UNIX Kernel Module\n",
+			expectedError: true,
+		},
+		{
+			description:   "no NVRM line",
+			contents:      "GCC version:  gcc version 11.4.0\n",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			version, err := parseDriverVersion(strings.NewReader(tc.contents))
+			if tc.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedVersion, version)
+		})
+	}
+}
+
+func TestCudaLibDriverVersion(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	testCases := []struct {
+		description           string
+		tegraReleaseFile      string
+		driverVersionFile     string
+		expectedDriver        string
+		expectedDriverIsError bool
+		expectedL4T           string
+		expectedL4TIsError    bool
+	}{
+		{
+			description:       "driver version file is used",
+			tegraReleaseFile:  "testdata/nv_tegra_release",
+			driverVersionFile: "testdata/driver-version",
+			expectedDriver:    "540.4.0",
+			expectedL4T:       "35.4.1",
+		},
+		{
+			description:           "driver version is not supported without version file",
+			tegraReleaseFile:      "testdata/nv_tegra_release",
+			driverVersionFile:     missing,
+			expectedDriverIsError: true,
+			expectedL4T:           "35.4.1",
+		},
+		{
+			description:           "not supported without release files",
+			tegraReleaseFile:      missing,
+			driverVersionFile:     missing,
+			expectedDriverIsError: true,
+			expectedL4TIsError:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			l := &cudaLib{
+				tegraReleaseFile:  tc.tegraReleaseFile,
+				driverVersionFile: tc.driverVersionFile,
+			}
+
+			driverVersion, err := l.GetDriverVersion()
+			if tc.expectedDriverIsError {
+				require.ErrorIs(t, err, ErrNotSupported)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedDriver, driverVersion)
+
+			l4tVersion, err := l.GetL4TVersion()
+			if tc.expectedL4TIsError {
+				require.ErrorIs(t, err, ErrNotSupported)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedL4T, l4tVersion)
+		})
+	}
+}
+
+func TestCudaManagerHostRoot(t *testing.T) {
+	root := t.TempDir()
+	for file, fixture := range map[string]string{
+		tegraReleaseFile:  "testdata/nv_tegra_release",
+		DriverVersionFile: "testdata/driver-version",
+	} {
+		contents, err := os.ReadFile(fixture)
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Join(root, filepath.Dir(file)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, file), contents, 0644))
+	}
+
+	l := NewCudaManager(root)
+
+	driverVersion, err := l.GetDriverVersion()
+	require.NoError(t, err)
+	require.Equal(t, "540.4.0", driverVersion)
+
+	l4tVersion, err := l.GetL4TVersion()
+	require.NoError(t, err)
+	require.Equal(t, "35.4.1", l4tVersion)
+}
@@ -40,17 +40,34 @@ func NewMigEnabledDevice(migs ...*resource.DeviceMock) resource.Device {
 // NewDeviceMock creates a devices for testing which can have MIG enabled or disabled.
 func NewDeviceMock(migEnabled bool) *DeviceMock {
 	d := DeviceMock{resource.DeviceMock{
-		GetNameFunc: func() (string, error) { return "MOCKMODEL", nil },
+		GetNameFunc:       func() (string, error) { return "MOCKMODEL", nil },
+		GetAttributesFunc: func() (map[string]interface{}, error) { return nil, resource.ErrNotSupported },
 		GetCudaComputeCapabilityFunc: func() (int, int, error) {
 			if migEnabled {
 				return 0, 0, nil
 			}
 			return 8, 0, nil
 		},
-		GetTotalMemoryMBFunc: func() (uint64, error) { return uint64(300), nil },
-		IsMigEnabledFunc:     func() (bool, error) { return migEnabled, nil },
-		IsMigCapableFunc:     func() (bool, error) { return migEnabled, nil },
-		GetMigDevicesFunc:    func() ([]resource.Device, error) { return nil, nil },
+		GetTotalMemoryMBFunc:                 func() (uint64, error) { return uint64(300), nil },
+		IsMigEnabledFunc:                     func() (bool, error) { return migEnabled, nil },
+		IsMigCapableFunc:                     func() (bool, error) { return migEnabled, nil },
+		GetMigDevicesFunc:                    func() ([]resource.Device, error) { return nil, nil },
+		GetComputeModeFunc:                   func() (string, error) { return resource.ComputeModeDefault, nil },
+		GetConfComputeProtectedMemoryMiBFunc: func() (uint64, error) { return 0, resource.ErrNotSupported },
+		IsPersistenceModeEnabledFunc:         func() (bool, error) { return true, nil },
+		IsDisplayModeEnabledFunc:             func() (bool, error) { return false, nil },
+		IsDisplayActiveFunc:                  func() (bool, error) { return false, nil },
+		IsGspFirmwareEnabledFunc:             func() (bool, error) { return false, resource.ErrNotSupported },
+		GetVirtualizationModeFunc:            func() (string, error) { return resource.VirtualizationModeNone, nil },
+		GetPowerLimitsWFunc:                  func() (uint32, uint32, error) { return 400, 400, nil },
+		GetMaxClocksMHzFunc:                  func() (uint32, uint32, error) { return 1410, 1215, nil },
+		GetUUIDFunc:                          func() (string, error) { return "GPU-MOCK", nil },
+		GetSerialFunc:                        func() (string, error) { return "0000000000000", nil },
+		GetBoardPartNumberFunc:               func() (string, error) { return "000-00000-0000-000", nil },
+		GetPCIBusIDFunc:                      func() (string, error) { return "00000000:00:00.0", nil },
+		GetFabricInfoFunc:                    func() (resource.FabricInfo, error) { return resource.FabricInfo{}, resource.ErrNotSupported },
+		GetSupportedMigProfilesFunc:          func() ([]resource.MigProfile, error) { return nil, nil },
+		GetRemainingMigCapacityFunc:          func() (map[string]int, error) { return nil, nil },
 	}}
 	return &d
 }
@@ -99,6 +116,86 @@ func (d *DeviceMock) WithMigDevices(migs ...*resource.DeviceMock) *DeviceMock {
 	return d
 }
 
+// WithComputeMode sets the compute mode reported by the mocked device
+func (d *DeviceMock) WithComputeMode(mode string) *DeviceMock {
+	d.GetComputeModeFunc = func() (string, error) {
+		return mode, nil
+	}
+	return d
+}
+
+// WithPersistenceMode sets the persistence mode reported by the mocked device
+func (d *DeviceMock) WithPersistenceMode(enabled bool) *DeviceMock {
+	d.IsPersistenceModeEnabledFunc = func() (bool, error) {
+		return enabled, nil
+	}
+	return d
+}
+
+// WithAttributes sets the attributes reported by the mocked device
+func (d *DeviceMock) WithAttributes(attributes map[string]interface{}) *DeviceMock {
+	d.GetAttributesFunc = func() (map[string]interface{}, error) {
+		return attributes, nil
+	}
+	return d
+}
+
+// WithGspFirmware sets whether the GSP firmware is reported as enabled by the mocked device
+func (d *DeviceMock) WithGspFirmware(enabled bool) *DeviceMock {
+	d.IsGspFirmwareEnabledFunc = func() (bool, error) {
+		return enabled, nil
+	}
+	return d
+}
+
+// WithVirtualizationMode sets the virtualization mode reported by the mocked device
+func (d *DeviceMock) WithVirtualizationMode(mode string) *DeviceMock {
+	d.GetVirtualizationModeFunc = func() (string, error) {
+		return mode, nil
+	}
+	return d
+}
+
+// WithUUID sets the UUID reported by the mocked device
+func (d *DeviceMock) WithUUID(uuid string) *DeviceMock {
+	d.GetUUIDFunc = func() (string, error) {
+		return uuid, nil
+	}
+	return d
+}
+
+// WithFabricInfo sets the GPU fabric info reported by the mocked device
+func (d *DeviceMock) WithFabricInfo(info resource.FabricInfo) *DeviceMock {
+	d.GetFabricInfoFunc = func() (resource.FabricInfo, error) {
+		return info, nil
+	}
+	return d
+}
+
+// WithConfComputeProtectedMemoryMiB sets the confidential computing protected memory reported by the mocked device
+func (d *DeviceMock) WithConfComputeProtectedMemoryMiB(size uint64) *DeviceMock {
+	d.GetConfComputeProtectedMemoryMiBFunc = func() (uint64, error) {
+		return size, nil
+	}
+	return d
+}
+
+// WithSupportedMigProfiles sets the MIG profiles supported by the mocked device
+func (d *DeviceMock) WithSupportedMigProfiles(profiles ...resource.MigProfile) *DeviceMock {
+	d.GetSupportedMigProfilesFunc = func() ([]resource.MigProfile, error) {
+		return profiles, nil
+	}
+	return d
+}
+
+// WithRemainingMigCapacity sets the remaining MIG capacity reported by the mocked device
+func (d *DeviceMock) WithRemainingMigCapacity(remaining map[string]int) *DeviceMock {
+	d.GetRemainingMigCapacityFunc = func() (map[string]int, error) {
+		return remaining, nil
+	}
+	return d
+}
+
 // ManagerMock provides an alias that allows for additional functions to be defined.
 type ManagerMock struct {
 	resource.ManagerMock
@@ -120,10 +217,32 @@ func NewManagerMockWithDevices(devices ...resource.Device) *ManagerMock {
 			var minor uint = 0
 			return &major, &minor, nil
 		},
+		GetConfComputeStateFunc: func() (resource.ConfComputeState, error) {
+			return resource.ConfComputeState{}, resource.ErrNotSupported
+		},
+		GetL4TVersionFunc: func() (string, error) {
+			return "", resource.ErrNotSupported
+		},
 	}}
 	return &manager
 }
 
+// WithConfComputeState sets the confidential computing state reported by the mocked manager
+func (m *ManagerMock) WithConfComputeState(state resource.ConfComputeState) *ManagerMock {
+	m.GetConfComputeStateFunc = func() (resource.ConfComputeState, error) {
+		return state, nil
+	}
+	return m
+}
+
+// WithL4TVersion sets the L4T version reported by the mocked manager
+func (m *ManagerMock) WithL4TVersion(version string) *ManagerMock {
+	m.GetL4TVersionFunc = func() (string, error) {
+		return version, nil
+	}
+	return m
+}
+
 // WithErrorOnInit sets the Init function for the ManagerMock to error if called.
 func (m *ManagerMock) WithErrorOnInit(err error) *ManagerMock {
 	m.InitFunc = func() error {
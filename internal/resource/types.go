@@ -16,6 +16,11 @@
 
 package resource
 
+import "errors"
+
+// ErrNotSupported is returned (possibly wrapped) when a device property cannot be queried for a device.
+var ErrNotSupported = errors.New("not supported")
+
 // Manager defines an interface for managing devices
 //
 //go:generate moq -out manager_mock.go . Manager
@@ -25,6 +30,8 @@ type Manager interface {
 	GetDevices() ([]Device, error)
 	GetDriverVersion() (string, error)
 	GetCudaDriverVersion() (*uint, *uint, error)
+	GetConfComputeState() (ConfComputeState, error)
+	GetL4TVersion() (string, error)
 }
 
 // Device defines an interface for a device with which labels are associated
@@ -39,4 +46,76 @@ type Device interface {
 	GetTotalMemoryMB() (uint64, error)
 	GetDeviceHandleFromMigDeviceHandle() (Device, error)
 	GetCudaComputeCapability() (int, int, error)
+	GetComputeMode() (string, error)
+	GetConfComputeProtectedMemoryMiB() (uint64, error)
+	IsPersistenceModeEnabled() (bool, error)
+	IsDisplayModeEnabled() (bool, error)
+	IsDisplayActive() (bool, error)
+	IsGspFirmwareEnabled() (bool, error)
+	GetVirtualizationMode() (string, error)
+	GetPowerLimitsW() (uint32, uint32, error)
+	GetMaxClocksMHz() (uint32, uint32, error)
+	GetUUID() (string, error)
+	GetSerial() (string, error)
+	GetBoardPartNumber() (string, error)
+	GetPCIBusID() (string, error)
+	GetFabricInfo() (FabricInfo, error)
+	GetSupportedMigProfiles() ([]MigProfile, error)
+	GetRemainingMigCapacity() (map[string]int, error)
+}
+
+// Compute modes as returned by Device.GetComputeMode
+const (
+	ComputeModeDefault          = "default"
+	ComputeModeExclusiveThread  = "exclusive-thread"
+	ComputeModeProhibited       = "prohibited"
+	ComputeModeExclusiveProcess = "exclusive-process"
+	ComputeModeUnknown          = "unknown"
+)
+
+// Virtualization modes as returned by Device.GetVirtualizationMode
+const (
+	VirtualizationModeNone        = "none"
+	VirtualizationModePassthrough = "passthrough"
+	VirtualizationModeVGPU        = "vgpu-guest"
+	VirtualizationModeHostVGPU    = "host-vgpu"
+	VirtualizationModeHostVSGA    = "host-vsga"
+	VirtualizationModeUnknown     = "unknown"
+)
+
+// FabricInfo holds the GPU fabric (multi-node NVLink) registration of a device as returned by Device.GetFabricInfo
+type FabricInfo struct {
+	State       string
+	ClusterUUID string
+	CliqueID    uint32
+}
+
+// Fabric registration states as returned by Device.GetFabricInfo
+const (
+	FabricStateNotStarted = "not-started"
+	FabricStateInProgress = "in-progress"
+	FabricStateCompleted  = "completed"
+	FabricStateFailed     = "failed"
+)
+
+// ConfComputeState holds the confidential computing state of the system as returned by Manager.GetConfComputeState
+type ConfComputeState struct {
+	Mode  string
+	Ready bool
+}
+
+// Confidential computing modes as returned by Manager.GetConfComputeState
+const (
+	ConfComputeModeOff      = "off"
+	ConfComputeModeOn       = "on"
+	ConfComputeModeDevTools = "devtools"
+)
+
+// MigProfile describes a GPU instance or compute instance profile supported by a MIG-capable device as returned
+// by Device.GetSupportedMigProfiles
+type MigProfile struct {
+	// Name is the name of the profile (e.g. 1g.10gb or 1c.3g.40gb) with any attributes separated by a '.' (e.g. 1g.10gb.me)
+	Name string
+	// MaxInstances is the maximum number of instances of the profile that can be created on the device
+	MaxInstances int
 }
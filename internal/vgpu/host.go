@@ -0,0 +1,163 @@
+/**
+# Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package vgpu
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MdevSupportedTypesDir is the sysfs directory listing the mediated device types supported by a PCI device
+	MdevSupportedTypesDir = "mdev_supported_types"
+	// SriovTotalVFsFile is the sysfs file holding the maximum number of SR-IOV virtual functions of a PCI device
+	SriovTotalVFsFile = "sriov_totalvfs"
+	// SriovNumVFsFile is the sysfs file holding the number of enabled SR-IOV virtual functions of a PCI device
+	SriovNumVFsFile = "sriov_numvfs"
+)
+
+// mdevMaxInstanceRegexp extracts the maximum number of instances per GPU from the description of an
+// NVIDIA mediated device type, e.g. "num_heads=4, frl_config=60, framebuffer=4096M, ..., max_instance=10".
+var mdevMaxInstanceRegexp = regexp.MustCompile(`(?:^|[\s,])max_instance=(\d+)`)
+
+// MdevType represents a mediated device (vGPU) type supported by a PCI device on a vGPU host
+type MdevType struct {
+	ID                 string
+	Name               string
+	AvailableInstances int
+	// MaxInstances is the maximum number of instances of the type on a physical GPU or 0 if it is not known
+	MaxInstances int
+}
+
+// SriovInfo represents the SR-IOV virtual function counts of a physical function
+type SriovInfo struct {
+	TotalVFs int
+	NumVFs   int
+}
+
+// IsVirtualFunction checks whether the PCI device is an SR-IOV virtual function
+func (d *PCIDevice) IsVirtualFunction() bool {
+	_, err := os.Lstat(path.Join(d.Path, "physfn"))
+	return err == nil
+}
+
+// GetPhysicalFunctionAddress returns the PCI address of the physical function of the PCI device.
+// If the device is not an SR-IOV virtual function, its own address is returned.
+func (d *PCIDevice) GetPhysicalFunctionAddress() (string, error) {
+	link, err := os.Readlink(path.Join(d.Path, "physfn"))
+	if os.IsNotExist(err) {
+		return d.Address, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to read physical function of %s: %v", d.Address, err)
+	}
+	return path.Base(link), nil
+}
+
+// GetSriovInfo returns the SR-IOV virtual function counts of the PCI device.
+// If the device is not SR-IOV capable, nil is returned.
+func (d *PCIDevice) GetSriovInfo() (*SriovInfo, error) {
+	total, err := readSysfsInt(path.Join(d.Path, SriovTotalVFsFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read total VFs for %s: %v", d.Address, err)
+	}
+
+	num, err := readSysfsInt(path.Join(d.Path, SriovNumVFsFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read number of VFs for %s: %v", d.Address, err)
+	}
+
+	return &SriovInfo{TotalVFs: total, NumVFs: num}, nil
+}
+
+// GetMdevTypes returns the mediated device types supported by the PCI device sorted by ID.
+// If the vGPU manager does not expose any mediated device types for the device, nil is returned.
+func (d *PCIDevice) GetMdevTypes() ([]*MdevType, error) {
+	typesDir := path.Join(d.Path, MdevSupportedTypesDir)
+	typeDirs, err := os.ReadDir(typesDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read mdev supported types for %s: %v", d.Address, err)
+	}
+
+	var types []*MdevType
+	for _, typeDir := range typeDirs {
+		typePath := path.Join(typesDir, typeDir.Name())
+
+		name, err := os.ReadFile(path.Join(typePath, "name"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read name of mdev type %s for %s: %v", typeDir.Name(), d.Address, err)
+		}
+
+		available, err := readSysfsInt(path.Join(typePath, "available_instances"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read available instances of mdev type %s for %s: %v", typeDir.Name(), d.Address, err)
+		}
+
+		maxInstances, err := readMdevMaxInstances(path.Join(typePath, "description"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read description of mdev type %s for %s: %v", typeDir.Name(), d.Address, err)
+		}
+
+		types = append(types, &MdevType{
+			ID:                 typeDir.Name(),
+			Name:               strings.TrimSpace(string(name)),
+			AvailableInstances: available,
+			MaxInstances:       maxInstances,
+		})
+	}
+
+	sort.Slice(types, func(i, j int) bool { return types[i].ID < types[j].ID })
+
+	return types, nil
+}
+
+// readMdevMaxInstances reads the maximum number of instances per GPU from the description file of a mediated
+// device type. If the file does not exist or does not include the maximum number of instances, 0 is returned.
+func readMdevMaxInstances(file string) (int, error) {
+	content, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	match := mdevMaxInstanceRegexp.FindStringSubmatch(string(content))
+	if match == nil {
+		return 0, nil
+	}
+	return strconv.Atoi(match[1])
+}
+
+// readSysfsInt reads a sysfs file containing a single integer
+func readSysfsInt(file string) (int, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(content)))
+}
@@ -17,9 +17,12 @@
 package vgpu
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
+	"regexp"
 	"strings"
 )
 
@@ -38,7 +41,7 @@ type PCIDevice struct {
 }
 
 const (
-	// PciDevicesRoot represents base path for all pci devices under sysfs
+	// PciDevicesRoot represents base path for all pci devices under sysfs (relative to the host root)
 	PciDevicesRoot = "/sys/bus/pci/devices"
 	// PciStatusByte indicates status byte
 	PciStatusByte = 0x06
@@ -56,26 +59,63 @@ const (
 	PciCapabilityVendorSpecificID = 0x09
 	// PciNvidiaVendorID represents PCI vendor id for Nvidia
 	PciNvidiaVendorID = "0x10de"
+	// PciDisplayControllerClass represents the PCI base class of display controllers (e.g. GPUs)
+	PciDisplayControllerClass = "0x03"
+	// PciNetworkControllerClass represents the PCI base class of network controllers (e.g. NICs)
+	PciNetworkControllerClass = "0x02"
 )
 
+// ErrIncompleteConfigSpace is returned (possibly wrapped) when less than the 256 bytes of the PCI configuration
+// space that hold the capability list can be read. This is the case when GFD is not run in privileged mode.
+var ErrIncompleteConfigSpace = errors.New("entire PCI configuration is not read")
+
+// pciAddressRegexp matches a PCI address in domain:bus:device.function format
+var pciAddressRegexp = regexp.MustCompile(`^[0-9a-f]{4}:[0-9a-f]{2}:[0-9a-f]{2}\.[0-7]$`)
+
 // NvidiaPCILib implements the NvidiaPCI interface
-type NvidiaPCILib struct{}
+type NvidiaPCILib struct {
+	root string
+}
 
-// NewNvidiaPCILib returns an instance of NvidiaPCILib implementing the NvidiaPCI interface
-func NewNvidiaPCILib() NvidiaPCI {
-	return &NvidiaPCILib{}
+// NewNvidiaPCILib returns an instance of NvidiaPCILib implementing the NvidiaPCI interface.
+// The PCI devices are read from sysfs below the specified host root.
+func NewNvidiaPCILib(hostRoot string) NvidiaPCI {
+	return &NvidiaPCILib{root: filepath.Join(hostRoot, PciDevicesRoot)}
 }
 
-// Devices returns all PCI devices on the system
+// Devices returns all NVIDIA PCI devices on the system
 func (p *NvidiaPCILib) Devices() ([]*PCIDevice, error) {
-	deviceDirs, err := os.ReadDir(PciDevicesRoot)
+	return getPCIDevices(p.root, func(vendor string) bool {
+		return vendor == PciNvidiaVendorID
+	})
+}
+
+// PCILib implements the NvidiaPCI interface for the PCI devices of all vendors
+type PCILib struct {
+	root string
+}
+
+// NewPCILib returns an instance of PCILib implementing the NvidiaPCI interface.
+// The PCI devices are read from sysfs below the specified host root.
+func NewPCILib(hostRoot string) NvidiaPCI {
+	return &PCILib{root: filepath.Join(hostRoot, PciDevicesRoot)}
+}
+
+// Devices returns all PCI devices on the system
+func (p *PCILib) Devices() ([]*PCIDevice, error) {
+	return getPCIDevices(p.root, func(string) bool { return true })
+}
+
+// getPCIDevices returns the PCI devices under the specified sysfs root with a vendor matching the filter
+func getPCIDevices(root string, vendorFilter func(string) bool) ([]*PCIDevice, error) {
+	deviceDirs, err := os.ReadDir(root)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read PCI bus devices: %v", err)
 	}
 
 	var devices []*PCIDevice
 	for _, deviceDir := range deviceDirs {
-		devicePath := path.Join(PciDevicesRoot, deviceDir.Name())
+		devicePath := path.Join(root, deviceDir.Name())
 		address := deviceDir.Name()
 
 		vendor, err := os.ReadFile(path.Join(devicePath, "vendor"))
@@ -83,7 +123,7 @@ func (p *NvidiaPCILib) Devices() ([]*PCIDevice, error) {
 			return nil, fmt.Errorf("unable to read PCI device vendor id for %s: %v", address, err)
 		}
 
-		if strings.TrimSpace(string(vendor)) != PciNvidiaVendorID {
+		if !vendorFilter(strings.TrimSpace(string(vendor))) {
 			continue
 		}
 
@@ -111,10 +151,33 @@ func (p *NvidiaPCILib) Devices() ([]*PCIDevice, error) {
 	return devices, nil
 }
 
+// GetParentAddresses returns the addresses of the PCI bridges (root ports and switch ports) above the device
+// in the PCI hierarchy, starting at the root port.
+func (d *PCIDevice) GetParentAddresses() ([]string, error) {
+	resolved, err := filepath.EvalSymlinks(d.Path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve sysfs path for %s: %v", d.Address, err)
+	}
+
+	var parents []string
+	for _, component := range strings.Split(filepath.Dir(resolved), "/") {
+		if pciAddressRegexp.MatchString(component) {
+			parents = append(parents, component)
+		}
+	}
+	return parents, nil
+}
+
+// IsRDMACapable checks whether an RDMA (InfiniBand verbs) device is registered for the PCI device
+func (d *PCIDevice) IsRDMACapable() bool {
+	_, err := os.Stat(path.Join(d.Path, "infiniband"))
+	return err == nil
+}
+
 // GetVendorSpecificCapability returns the vendor specific capability from configuration space
 func (d *PCIDevice) GetVendorSpecificCapability() ([]byte, error) {
 	if len(d.Config) < 256 {
-		return nil, fmt.Errorf("entire PCI configuration is not read for device %s. Please run GFD with privileged mode to read complete PCI configuration data", d.Address)
+		return nil, fmt.Errorf("%w for device %s. Please run GFD with privileged mode to read complete PCI configuration data", ErrIncompleteConfigSpace, d.Address)
 	}
 
 	if d.Config[PciStatusByte]&PciStatusCapabilityList == 0 {
@@ -178,27 +241,42 @@ func (p *MockNvidiaPCI) Devices() ([]*PCIDevice, error) {
 
 // NewMockNvidiaPCI initializes and returns mock PCI interface type
 func NewMockNvidiaPCI() NvidiaPCI {
+	return NewMockNvidiaPCIWithDevices(MockPassthroughDevice, MockVGPUDevice)
+}
+
+// Addresses of the mocked PCI devices
+const (
+	// MockPassthroughDevice is a GPU passed through to a VM (or on bare metal)
+	MockPassthroughDevice = "passthrough"
+	// MockVGPUDevice is a vGPU in a guest VM
+	MockVGPUDevice = "vgpu"
+	// MockUnprivilegedDevice is a GPU of which only the configuration header can be read
+	MockUnprivilegedDevice = "unprivileged"
+)
+
+// NewMockNvidiaPCIWithDevices initializes and returns a mock PCI interface type with the mocked
+// PCI devices at the specified addresses
+func NewMockNvidiaPCIWithDevices(addresses ...string) NvidiaPCI {
 	var (
 		gpuPassThroughConfig = []byte{0xde, 0x10, 0x8a, 0x11, 0x07, 0x04, 0x10, 0x00, 0xa1, 0x00, 0x00, 0x03, 0x00, 0xf8, 0x00, 0x00, 0x00, 0x00, 0x00, 0xec, 0x0c, 0x00, 0x00, 0xe0, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x00, 0x00, 0xea, 0x00, 0x00, 0x00, 0x00, 0x01, 0xc1, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xde, 0x10, 0x14, 0x10, 0x00, 0x00, 0x00, 0xee, 0x60, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x01, 0x00, 0x00, 0xde, 0x10, 0x14, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0xce, 0xd6, 0x23, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x03, 0x00, 0x08, 0x00, 0x00, 0x00, 0x05, 0x78, 0x81, 0x00, 0x00, 0x70, 0xe6, 0xfe, 0x00, 0x00, 0x00, 0x00, 0x00, 0x43, 0x00, 0x00, 0x10, 0xb4, 0x02, 0x00, 0xe1, 0x8d, 0x64, 0x00, 0x10, 0x29, 0x00, 0x00, 0x03, 0x3d, 0x45, 0x10, 0x00, 0x00, 0x01, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x00, 0x00, 0x00, 0x03, 0x00, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x09, 0x00, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
 		vgpuConfig           = []byte{0xde, 0x10, 0xb8, 0x1e, 0x02, 0x05, 0xff, 0x06, 0xa1, 0x00, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x0c, 0x00, 0x00, 0xd0, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0xfa, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xde, 0x10, 0x0f, 0x13, 0x00, 0x00, 0x00, 0x00, 0xd0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0xce, 0xd6, 0x23, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x00, 0x81, 0x00, 0x00, 0x00, 0xe0, 0xfe, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x09, 0x68, 0x1b, 0x56, 0x46, 0x00, 0x16, 0x34, 0x36, 0x30, 0x2e, 0x31, 0x36, 0x00, 0x00, 0x00, 0x00, 0x72, 0x34, 0x36, 0x30, 0x5f, 0x30, 0x30, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
 	)
 
-	return &MockNvidiaPCI{
-		devices: []*PCIDevice{
-			{
-				Path:    "",
-				Address: "passthrough",
-				Vendor:  "0x10de",
-				Class:   "300",
-				Config:  gpuPassThroughConfig,
-			},
-			{
-				Path:    "",
-				Address: "vgpu",
-				Vendor:  "0x10de",
-				Class:   "300",
-				Config:  vgpuConfig,
-			},
-		},
+	configs := map[string][]byte{
+		MockPassthroughDevice:  gpuPassThroughConfig,
+		MockVGPUDevice:         vgpuConfig,
+		MockUnprivilegedDevice: gpuPassThroughConfig[:64],
+	}
+
+	mock := &MockNvidiaPCI{}
+	for _, address := range addresses {
+		mock.devices = append(mock.devices, &PCIDevice{
+			Path:    "",
+			Address: address,
+			Vendor:  "0x10de",
+			Class:   "300",
+			Config:  configs[address],
+		})
 	}
+	return mock
 }
@@ -18,6 +18,8 @@ package vgpu
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -40,3 +42,54 @@ func TestGetVendorSpecificCapability(t *testing.T) {
 		}
 	}
 }
+
+func TestPCILibDevices(t *testing.T) {
+	root := t.TempDir()
+	devices := map[string]string{
+		"0000:05:00.0": "0x10de",
+		"0000:08:00.0": "0x15b3",
+	}
+	for address, vendor := range devices {
+		devicePath := filepath.Join(root, address)
+		require.NoError(t, os.MkdirAll(devicePath, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(devicePath, "vendor"), []byte(vendor+"\n"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(devicePath, "class"), []byte("0x020700\n"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(devicePath, "config"), make([]byte, 64), 0644))
+	}
+
+	all, err := (&PCILib{root: root}).Devices()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	nvidia, err := getPCIDevices(root, func(vendor string) bool { return vendor == PciNvidiaVendorID })
+	require.NoError(t, err)
+	require.Len(t, nvidia, 1)
+	require.Equal(t, "0000:05:00.0", nvidia[0].Address)
+	require.Equal(t, "0x02", nvidia[0].Class)
+}
+
+func TestNvidiaPCILibHostRoot(t *testing.T) {
+	hostRoot := t.TempDir()
+	root := filepath.Join(hostRoot, PciDevicesRoot)
+	devices := map[string]string{
+		"0000:05:00.0": "0x10de",
+		"0000:08:00.0": "0x15b3",
+	}
+	for address, vendor := range devices {
+		devicePath := filepath.Join(root, address)
+		require.NoError(t, os.MkdirAll(devicePath, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(devicePath, "vendor"), []byte(vendor+"\n"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(devicePath, "class"), []byte("0x030200\n"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(devicePath, "config"), make([]byte, 64), 0644))
+	}
+
+	nvidia, err := NewNvidiaPCILib(hostRoot).Devices()
+	require.NoError(t, err)
+	require.Len(t, nvidia, 1)
+	require.Equal(t, "0000:05:00.0", nvidia[0].Address)
+	require.Equal(t, filepath.Join(root, "0000:05:00.0"), nvidia[0].Path)
+
+	all, err := NewPCILib(hostRoot).Devices()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+}
@@ -66,14 +66,14 @@ func NewMockVGPU() Interface {
 func (v *Lib) Devices() ([]*Device, error) {
 	pciDevices, err := v.pci.Devices()
 	if err != nil {
-		return nil, fmt.Errorf("error getting NVIDIA specific PCI devices: %v", err)
+		return nil, fmt.Errorf("error getting NVIDIA specific PCI devices: %w", err)
 	}
 
 	var vgpus []*Device
 	for _, device := range pciDevices {
 		capability, err := device.GetVendorSpecificCapability()
 		if err != nil {
-			return nil, fmt.Errorf("unable to read vendor specific capability for %s: %v", device.Address, err)
+			return nil, fmt.Errorf("unable to read vendor specific capability for %s: %w", device.Address, err)
 		}
 		if capability == nil {
 			continue
@@ -104,7 +104,8 @@ func (v *Lib) IsVGPUDevice(capability []byte) bool {
 	return true
 }
 
-// GetInfo returns information about vGPU manager running on the underlying hypervisor host
+// GetInfo returns information about vGPU manager running on the underlying hypervisor host.
+// Only the host driver version record is decoded; the layout of the other records is not documented.
 func (d *Device) GetInfo() (*Info, error) {
 	if len(d.vGPUCapability) == 0 {
 		return nil, fmt.Errorf("vendor capability record is not populated for device %s", d.pci.Address)
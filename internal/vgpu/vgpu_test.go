@@ -72,3 +72,16 @@ func TestVGPUGetInfo(t *testing.T) {
 		}
 	}
 }
+
+func TestGetVendorSpecificCapabilityIncompleteConfig(t *testing.T) {
+	pci := NewMockNvidiaPCIWithDevices(MockUnprivilegedDevice)
+	devices, err := pci.Devices()
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+
+	_, err = devices[0].GetVendorSpecificCapability()
+	require.ErrorIs(t, err, ErrIncompleteConfigSpace)
+
+	_, err = NewVGPULib(pci).Devices()
+	require.ErrorIs(t, err, ErrIncompleteConfigSpace)
+}